@@ -0,0 +1,184 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bcfynego
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/account"
+	"aletheiaware.com/cryptogo"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+)
+
+const (
+	RSA_ACCOUNT  = "RSA"
+	RSA_KEY_SIZE = 4096
+)
+
+// ErrNoKey is returned when no account factory finds a key of the alias in the keystore.
+type ErrNoKey struct {
+	Alias string
+}
+
+func (e ErrNoKey) Error() string {
+	return fmt.Sprintf("No key found for %s", e.Alias)
+}
+
+// AccountFactory creates, loads and converts accounts holding keys of one algorithm.
+// The embedded KeyCodec re-encrypts keys, and parses the public keys of identities.
+type AccountFactory interface {
+	storage.KeyCodec
+	// Name returns the name of the key algorithm, as offered when signing up.
+	Name() string
+	// Aliases returns the aliases with a key of this algorithm in the keystore.
+	Aliases(keystore string) ([]string, error)
+	// Create generates a new key, writes it to the keystore encrypted with the password, and returns an account holding it.
+	// Nothing is written to the keystore if the context is done before the key is generated.
+	Create(ctx context.Context, keystore, alias string, password []byte) (bcgo.Account, error)
+	// Archive keeps a copy of the key of the alias in the keystore, so records shared with it can still be decrypted after rotation,
+	// and returns the directory holding the copy.
+	Archive(keystore, alias string, password []byte) (string, error)
+	// Export returns the key of the alias in the keystore as a key file encrypted with the password, for use outside the keystore.
+	Export(keystore, alias string, password []byte) ([]byte, error)
+	// Parse decrypts the key file with the password, and returns an account holding the key.
+	Parse(alias string, data, password []byte) (bcgo.Account, error)
+	// Import decrypts the key file with the password, and writes the key to the keystore encrypted with the same password.
+	Import(keystore, alias string, data, password []byte) error
+}
+
+type rsaAccountFactory struct {
+	storage.KeyCodec
+}
+
+// NewRSAAccountFactory returns an AccountFactory of RSA keys.
+func NewRSAAccountFactory() AccountFactory {
+	return &rsaAccountFactory{
+		KeyCodec: storage.NewRSAKeyCodec(),
+	}
+}
+
+func (r *rsaAccountFactory) Name() string {
+	return RSA_ACCOUNT
+}
+
+func (r *rsaAccountFactory) Aliases(keystore string) ([]string, error) {
+	return cryptogo.ListRSAPrivateKeys(keystore)
+}
+
+func (r *rsaAccountFactory) Create(ctx context.Context, keystore, alias string, password []byte) (bcgo.Account, error) {
+	type result struct {
+		key *rsa.PrivateKey
+		err error
+	}
+	// Key generation cannot be interrupted, so it is abandoned if the context is done first
+	results := make(chan result, 1)
+	go func() {
+		key, err := rsa.GenerateKey(rand.Reader, RSA_KEY_SIZE)
+		results <- result{key, err}
+	}()
+	var key *rsa.PrivateKey
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+		key = res.key
+	}
+	if err := cryptogo.WriteRSAPrivateKey(key, keystore, alias, password); err != nil {
+		return nil, err
+	}
+	return account.NewRSA(alias, key), nil
+}
+
+func (r *rsaAccountFactory) Archive(keystore, alias string, password []byte) (string, error) {
+	return storage.ArchiveKey(r, keystore, alias, password)
+}
+
+func (r *rsaAccountFactory) Export(keystore, alias string, password []byte) ([]byte, error) {
+	key, err := cryptogo.RSAPrivateKey(keystore, alias, password)
+	if err != nil {
+		return nil, err
+	}
+	return storage.EncodeKeyFile(key, password)
+}
+
+func (r *rsaAccountFactory) Parse(alias string, data, password []byte) (bcgo.Account, error) {
+	key, err := storage.ParseRSAKeyFile(data, password)
+	if err != nil {
+		return nil, err
+	}
+	return account.NewRSA(alias, key), nil
+}
+
+func (r *rsaAccountFactory) Import(keystore, alias string, data, password []byte) error {
+	key, err := storage.ParseRSAKeyFile(data, password)
+	if err != nil {
+		return err
+	}
+	return cryptogo.WriteRSAPrivateKey(key, keystore, alias, password)
+}
+
+// FactoryForAlias returns the first of the factories with a key of the alias in the keystore.
+func FactoryForAlias(factories []AccountFactory, keystore, alias string) (AccountFactory, error) {
+	for _, f := range factories {
+		aliases, err := f.Aliases(keystore)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range aliases {
+			if a == alias {
+				return f, nil
+			}
+		}
+	}
+	return nil, ErrNoKey{
+		Alias: alias,
+	}
+}
+
+// FactoryForKeyFile returns the first of the factories which can decrypt the key file with the password, along with an account holding the key.
+// The error of the first factory is returned if none can.
+func FactoryForKeyFile(factories []AccountFactory, alias string, data, password []byte) (AccountFactory, bcgo.Account, error) {
+	var first error
+	for _, f := range factories {
+		account, err := f.Parse(alias, data, password)
+		if err == nil {
+			return f, account, nil
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	if first == nil {
+		first = storage.ErrNotPrivateKey
+	}
+	return nil, nil, first
+}
+
+// KeyCodecs returns the key codecs of the factories, such as to parse the public keys of identities.
+func KeyCodecs(factories []AccountFactory) []storage.KeyCodec {
+	codecs := make([]storage.KeyCodec, len(factories))
+	for i, f := range factories {
+		codecs[i] = f
+	}
+	return codecs
+}
@@ -31,6 +31,7 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"log"
+	"strconv"
 	"strings"
 )
 
@@ -59,6 +60,26 @@ func main() {
 	// Create BC Fyne
 	f := bcfynego.NewBCFyne(a, w)
 
+	// Create Scheduler to sync watched channels in the background
+	s := ui.NewScheduler(c, a.Preferences())
+	status := ui.NewSyncStatusView(f, c, s)
+	s.OnUpdate = status.Update
+
+	// Create Notifier to announce new records on watched channels
+	n := ui.NewNotifier(f, c, a)
+	notifications := ui.NewNotificationView(f, c, n)
+	n.OnUpdate = notifications.Update
+	s.OnHeadChanged = func(channel string, before, after []byte) {
+		ui.UpdateChannelReferences(f, c, channel)
+		ui.UpdateInbox(f, c, channel)
+		n.HeadChanged(channel, before, after)
+	}
+	s.Start()
+	defer s.Stop()
+
+	// Catch up the reference index with channels cached while the app was closed
+	go ui.UpdateReferenceIndex(f, c)
+
 	location := widget.NewEntry()
 	location.SetPlaceHolder("Channel")
 
@@ -87,19 +108,31 @@ func main() {
 		widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
 			go setAddressAction(location.Text)
 		}),
+		widget.NewButtonWithIcon("", theme.SearchIcon(), func() {
+			go f.ShowAliases(c)
+		}),
+		widget.NewButtonWithIcon("", theme.FolderNewIcon(), func() {
+			go f.ShowCreateChannel(c)
+		}),
+		widget.NewButtonWithIcon("", theme.MailComposeIcon(), func() {
+			go f.ShowInbox(c)
+		}),
 		widget.NewButtonWithIcon("", theme.NewThemedResource(data.AccountIcon), func() {
 			go f.ShowAccount(c)
 		}),
 		widget.NewButtonWithIcon("", theme.SettingsIcon(), func() {
-			go settings(f, c)
+			go settings(f, c, s)
 		}),
-	), location), nil, nil, nil, f.Logo()))
+	), location), widget.NewAccordion(
+		widget.NewAccordionItem("Sync Status", status),
+		widget.NewAccordionItem("Notifications", notifications),
+	), nil, nil, f.Logo()))
 	w.Resize(ui.WindowSize)
 	w.CenterOnScreen()
 	w.ShowAndRun()
 }
 
-func settings(f bcfynego.BCFyne, c bcclientgo.BCClient) {
+func settings(f bcfynego.BCFyne, c bcclientgo.BCClient, s *ui.Scheduler) {
 	form := widget.NewForm()
 
 	root := ui.NewRootView(func() string {
@@ -165,6 +198,62 @@ func settings(f bcfynego.BCFyne, c bcclientgo.BCClient) {
 		),
 	))
 
+	preferences := f.App().Preferences()
+	watchedList := &widget.List{
+		Length: func() int {
+			return len(storage.WatchedChannels(preferences))
+		},
+		CreateItem: func() fyne.CanvasObject {
+			return container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentRemoveIcon(), nil), widget.NewLabel(""))
+		},
+	}
+	watchedList.UpdateItem = func(index widget.ListItemID, item fyne.CanvasObject) {
+		channels := storage.WatchedChannels(preferences)
+		if index < 0 || index >= len(channels) {
+			return
+		}
+		channel := channels[index]
+		os := item.(*fyne.Container).Objects
+		os[0].(*widget.Label).SetText(channel)
+		os[1].(*widget.Button).OnTapped = func() {
+			storage.Unwatch(preferences, channel)
+			form.Refresh()
+		}
+	}
+	interval := widget.NewSelect([]string{"5", "15", "30", "60", "360", "1440"}, func(minutes string) {
+		if m, err := strconv.Atoi(minutes); err == nil {
+			storage.SetSyncInterval(preferences, m)
+		}
+	})
+	interval.SetSelected(strconv.Itoa(int(storage.SyncInterval(preferences).Minutes())))
+	push := widget.NewCheck("Push after Pull", func(checked bool) {
+		storage.SetSyncPush(preferences, checked)
+	})
+	push.SetChecked(storage.SyncPush(preferences))
+	form.Append("Watched", container.NewVBox(
+		watchedList,
+		container.NewGridWithColumns(2,
+			widget.NewButton("Add", func() {
+				entry := widget.NewEntry()
+				entry.SetPlaceHolder("Channel")
+				dialog.ShowForm("Watch Channel", "Add", "Cancel", []*widget.FormItem{
+					widget.NewFormItem("Channel", entry),
+				}, func(ok bool) {
+					if ok {
+						storage.Watch(preferences, entry.Text)
+						form.Refresh()
+						s.SyncNow()
+					}
+				}, f.Window())
+			}),
+			widget.NewButton("Sync Now", func() {
+				s.SyncNow()
+			}),
+		),
+		widget.NewForm(widget.NewFormItem("Interval (minutes)", interval)),
+		push,
+	))
+
 	form.Append("Cache", container.NewVBox(
 		ui.NewCacheView(func() bcgo.Cache {
 			h, err := c.Cache()
@@ -24,26 +24,36 @@ import (
 	accountui "aletheiaware.com/bcfynego/ui/account"
 	"aletheiaware.com/bcfynego/ui/data"
 	"aletheiaware.com/bcgo"
-	"aletheiaware.com/bcgo/account"
 	"aletheiaware.com/bcgo/node"
 	"aletheiaware.com/cryptogo"
 	"bytes"
+	"context"
 	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
+	fynestorage "fyne.io/fyne/v2/storage"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"image"
+	_ "image/jpeg"
+	"image/png"
+	"io/ioutil"
 	"log"
 	"os"
 	"runtime/debug"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 type BCFyne interface {
+	ui.UI
 	App() fyne.App
 	Window() fyne.Window
+	AddAccountFactory(AccountFactory)
 	AddOnKeysExported(func(string))
 	AddOnKeysImported(func(string))
 	AddOnSignedIn(func(bcgo.Account))
@@ -54,9 +64,13 @@ type BCFyne interface {
 	Logo() fyne.CanvasObject
 	Account(bcclientgo.BCClient) (bcgo.Account, error)
 	Node(bcclientgo.BCClient) (bcgo.Node, error)
+	SetPasswordPolicy(*accountui.PasswordPolicy)
 	ShowAccessDialog(bcclientgo.BCClient, func(bcgo.Account))
 	ShowAccount(bcclientgo.BCClient)
+	ShowAliases(bcclientgo.BCClient)
+	ShowCreateChannel(bcclientgo.BCClient)
 	ShowError(error)
+	ShowInbox(bcclientgo.BCClient)
 	ShowURI(bcclientgo.BCClient, fyne.URI)
 	SignOut(bcclientgo.BCClient)
 }
@@ -64,17 +78,27 @@ type BCFyne interface {
 type bcFyne struct {
 	app            fyne.App
 	window         fyne.Window
+	factories      []AccountFactory
+	passwordPolicy *accountui.PasswordPolicy
 	onKeysExported []func(string)
 	onKeysImported []func(string)
 	onSignedIn     []func(bcgo.Account)
 	onSignedUp     []func(bcgo.Account)
 	onSignedOut    []func()
+	lock           sync.Mutex
+	index          storage.ReferenceIndex
+	contacts       storage.ContactBook
+	inbox          storage.Inbox
+	previous       []bcgo.Account
 }
 
 func NewBCFyne(a fyne.App, w fyne.Window) BCFyne {
 	return &bcFyne{
 		app:    a,
 		window: w,
+		factories: []AccountFactory{
+			NewRSAAccountFactory(),
+		},
 	}
 }
 
@@ -86,6 +110,26 @@ func (f *bcFyne) Window() fyne.Window {
 	return f.window
 }
 
+// AddAccountFactory adds a factory of accounts, offered when signing up and detected when signing in.
+func (f *bcFyne) AddAccountFactory(factory AccountFactory) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.factories = append(f.factories, factory)
+}
+
+func (f *bcFyne) accountFactories() []AccountFactory {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]AccountFactory{}, f.factories...)
+}
+
+// SetPasswordPolicy sets the policy passwords must meet when signing up.
+func (f *bcFyne) SetPasswordPolicy(policy *accountui.PasswordPolicy) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.passwordPolicy = policy
+}
+
 func (f *bcFyne) AddOnKeysExported(callback func(string)) {
 	f.onKeysExported = append(f.onKeysExported, callback)
 }
@@ -118,13 +162,22 @@ func (f *bcFyne) ExistingAccount(client bcclientgo.BCClient, alias string, passw
 		f.ShowError(err)
 		return
 	}
+	// Detect key type
+	factory, err := FactoryForAlias(f.accountFactories(), keystore, alias)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
 	// Get private key
-	key, err := cryptogo.RSAPrivateKey(keystore, alias, password)
+	account, err := factory.Load(keystore, alias, password)
 	if err != nil {
 		f.ShowError(err)
 		return
 	}
-	account := account.NewRSA(alias, key)
+	// Get keys archived by rotation, records shared with them cannot be read if they fail to load
+	if err := f.loadPrevious(factory, keystore, alias, password); err != nil {
+		f.ShowError(err)
+	}
 	if c := callback; c != nil {
 		c(account)
 	}
@@ -143,6 +196,10 @@ func (f *bcFyne) Account(client bcclientgo.BCClient) (bcgo.Account, error) {
 	return client.Account()
 }
 
+func (f *bcFyne) KeyCodecs() []storage.KeyCodec {
+	return KeyCodecs(f.accountFactories())
+}
+
 func (f *bcFyne) Node(client bcclientgo.BCClient) (bcgo.Node, error) {
 	if !client.HasNode() {
 		account, err := f.Account(client)
@@ -162,6 +219,68 @@ func (f *bcFyne) Node(client bcclientgo.BCClient) (bcgo.Node, error) {
 	return client.Node()
 }
 
+func (f *bcFyne) ContactBook(client bcclientgo.BCClient) (storage.ContactBook, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.contacts == nil {
+		rootDir, err := client.Root()
+		if err != nil {
+			return nil, err
+		}
+		contacts, err := storage.NewContactBook(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		f.contacts = contacts
+	}
+	return f.contacts, nil
+}
+
+func (f *bcFyne) Inbox(client bcclientgo.BCClient) (storage.Inbox, error) {
+	account, err := f.Account(client)
+	if err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.inbox == nil || f.inbox.Alias() != account.Alias() {
+		rootDir, err := client.Root()
+		if err != nil {
+			return nil, err
+		}
+		inbox, err := storage.NewInbox(rootDir, account.Alias())
+		if err != nil {
+			return nil, err
+		}
+		f.inbox = inbox
+	}
+	return f.inbox, nil
+}
+
+// PreviousAccounts returns the accounts holding the keys replaced by rotation of the signed in alias, newest first.
+func (f *bcFyne) PreviousAccounts(client bcclientgo.BCClient) []bcgo.Account {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]bcgo.Account{}, f.previous...)
+}
+
+func (f *bcFyne) ReferenceIndex(client bcclientgo.BCClient) (storage.ReferenceIndex, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.index == nil {
+		rootDir, err := client.Root()
+		if err != nil {
+			return nil, err
+		}
+		index, err := storage.NewReferenceIndex(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		f.index = index
+	}
+	return f.index, nil
+}
+
 func (f *bcFyne) Logo() fyne.CanvasObject {
 	return &canvas.Image{
 		Resource: data.Logo,
@@ -170,9 +289,96 @@ func (f *bcFyne) Logo() fyne.CanvasObject {
 	}
 }
 
-func (f *bcFyne) NewAccount(client bcclientgo.BCClient, alias string, password []byte, callback func(bcgo.Account)) {
+// openKeyFile signs in with a key file from outside the keystore, such as on removable media, once it is verified as the current key of the alias.
+// The key is copied into the keystore if requested.
+func (f *bcFyne) openKeyFile(client bcclientgo.BCClient, alias, file string, password []byte, copyKey bool, callback func(bcgo.Account)) {
+	u, err := fynestorage.ParseURI(file)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	reader, err := fynestorage.Reader(u)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	data, err := ioutil.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	// Detect key type
+	factory, account, err := FactoryForKeyFile(f.accountFactories(), alias, data, password)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	_, publicKey, err := account.PublicKey()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	cache, err := client.Cache()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	network, err := client.Network()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+
+	// Show Progress Dialog
+	progress := dialog.NewProgressInfinite("Verifying", "Verifying key of "+alias, f.window)
+	progress.Show()
+
+	// Verify against alias channel
+	err = storage.VerifyKey(f.KeyCodecs(), cache, network, alias, publicKey)
+
+	// Hide Progress Dialog
+	progress.Hide()
+
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	if copyKey {
+		rootDir, err := client.Root()
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Get key store
+		keystore, err := bcgo.KeyDirectory(rootDir)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		if _, err := FactoryForAlias(f.accountFactories(), keystore, alias); err == nil {
+			f.ShowError(fmt.Errorf("Keystore already holds a key for %s", alias))
+			return
+		}
+		if err := factory.Import(keystore, alias, data, password); err != nil {
+			f.ShowError(err)
+			return
+		}
+		for _, c := range f.onKeysImported {
+			c(alias)
+		}
+	}
+	f.lock.Lock()
+	f.previous = nil
+	f.lock.Unlock()
+	if c := callback; c != nil {
+		c(account)
+	}
+}
+
+func (f *bcFyne) NewAccount(client bcclientgo.BCClient, factory AccountFactory, alias string, password []byte, callback func(bcgo.Account)) {
 	// Show Progress Dialog
-	progress := dialog.NewProgressInfinite("Creating", "Creating "+alias, f.window)
+	progress, ctx := ui.NewCancellableProgress(context.Background(), "Creating", "Creating key for "+alias, f.window)
 	progress.Show()
 	defer progress.Hide()
 
@@ -187,13 +393,89 @@ func (f *bcFyne) NewAccount(client bcclientgo.BCClient, alias string, password [
 		f.ShowError(err)
 		return
 	}
-	// Create private key
-	key, err := cryptogo.CreateRSAPrivateKey(keystore, alias, password)
+	// Mark registration as pending before the key is written, so it is resumed even if the app stops before the alias record is mined
+	storage.SetRegistrationPending(f.app.Preferences(), alias, true)
+	// Create private key, staged first so only the file of this alias is added to the keystore, and removed if the sign up is cancelled
+	var account bcgo.Account
+	staged, err := storage.StageKey(factory, keystore, alias, password, func(directory string) (err error) {
+		account, err = factory.Create(ctx, directory, alias, password)
+		return
+	})
+	var file string
+	if err == nil {
+		if file, err = staged.Add(keystore); err != nil {
+			staged.Discard()
+		}
+	}
+	if err != nil {
+		storage.SetRegistrationPending(f.app.Preferences(), alias, false)
+		if ctx.Err() == nil {
+			f.ShowError(err)
+		}
+		return
+	}
+
+	progress.SetMessage("Registering " + alias)
+	if err := f.registerAlias(ctx, client, account, progress.MiningListener(aliasgo.ALIAS_THRESHOLD)); err != nil {
+		progress.Hide()
+		if ctx.Err() != nil {
+			f.abandonAccount(alias, file)
+		} else {
+			f.ShowError(err)
+		}
+		return
+	}
+
+	if c := callback; c != nil {
+		c(account)
+	}
+}
+
+// abandonAccount asks whether to keep the key file of a cancelled sign up, so its registration can be resumed, or to delete it.
+func (f *bcFyne) abandonAccount(alias, file string) {
+	message := &widget.Label{
+		Text:     "The key of " + alias + " was created but the alias is not registered. Keep the key to register later by signing up again with the same alias and password, or delete it.",
+		Wrapping: fyne.TextWrapWord,
+	}
+	d := dialog.NewCustomConfirm("Registration Cancelled", "Keep Key", "Delete Key", message, func(keep bool) {
+		if keep {
+			return
+		}
+		if err := os.Remove(file); err != nil {
+			f.ShowError(err)
+			return
+		}
+		storage.SetRegistrationPending(f.app.Preferences(), alias, false)
+	}, f.window)
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
+// resumeAccount signs in with the key of an alias created by the factory in an earlier sign up, and registers the alias unless it already is.
+// A key whose alias is registered is only resumed while its registration is marked pending, otherwise the user is asked to sign in.
+func (f *bcFyne) resumeAccount(client bcclientgo.BCClient, factory AccountFactory, alias string, password []byte, callback func(bcgo.Account)) {
+	rootDir, err := client.Root()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	// Get key store
+	keystore, err := bcgo.KeyDirectory(rootDir)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	// Get private key
+	account, err := factory.Load(keystore, alias, password)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	_, key, err := account.PublicKey()
 	if err != nil {
 		f.ShowError(err)
 		return
 	}
-	account := account.NewRSA(alias, key)
 	cache, err := client.Cache()
 	if err != nil {
 		f.ShowError(err)
@@ -204,39 +486,92 @@ func (f *bcFyne) NewAccount(client bcclientgo.BCClient, alias string, password [
 		f.ShowError(err)
 		return
 	}
-	// Create node
-	node := node.New(account, cache, network)
+	registered, err := storage.IsRegistered(f.KeyCodecs(), cache, network, alias, key)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	if !registered {
+		if err := f.showRegistration(client, account); err != nil {
+			if err != context.Canceled {
+				f.ShowError(err)
+			}
+			return
+		}
+	} else if storage.IsRegistrationPending(f.app.Preferences(), alias) {
+		// Registration was mined before the app stopped
+		storage.SetRegistrationPending(f.app.Preferences(), alias, false)
+	} else {
+		f.ShowError(fmt.Errorf("%s is already registered, sign in instead", alias))
+		return
+	}
+	// Get keys archived by rotation, as when signing in
+	if err := f.loadPrevious(factory, keystore, alias, password); err != nil {
+		f.ShowError(err)
+	}
+	if c := callback; c != nil {
+		c(account)
+	}
+}
 
-	{
-		// Show Progress Dialog
-		progress := dialog.NewProgress("Registering", "Registering "+alias, f.window)
-		progress.Show()
-		listener := &ui.ProgressMiningListener{Func: progress.SetValue}
+// showRegistration registers the alias of the account while showing progress which can be cancelled.
+func (f *bcFyne) showRegistration(client bcclientgo.BCClient, account bcgo.Account) error {
+	// Show Progress Dialog
+	progress, ctx := ui.NewCancellableProgress(context.Background(), "Registering", "Registering "+account.Alias(), f.window)
+	progress.Show()
 
-		// Register Alias
-		err := aliasgo.Register(node, listener)
+	// Register Alias
+	err := f.registerAlias(ctx, client, account, progress.MiningListener(aliasgo.ALIAS_THRESHOLD))
 
-		// Hide Progress Dialog
-		progress.Hide()
+	// Hide Progress Dialog
+	progress.Hide()
 
-		if err != nil {
-			f.ShowError(err)
-			return
-		}
+	return err
+}
+
+// registerAlias mines the alias record of the account until the context is done, and clears the pending mark once it is registered.
+func (f *bcFyne) registerAlias(ctx context.Context, client bcclientgo.BCClient, account bcgo.Account, listener bcgo.MiningListener) error {
+	cache, err := client.Cache()
+	if err != nil {
+		return err
 	}
+	network, err := client.Network()
+	if err != nil {
+		return err
+	}
+	// Create node
+	node := node.New(account, cache, network)
 
-	if c := callback; c != nil {
-		c(account)
+	// Register Alias
+	if err := storage.Register(ctx, node, listener); err != nil {
+		return err
 	}
+	storage.SetRegistrationPending(f.app.Preferences(), account.Alias(), false)
+	return nil
 }
 
 func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo.Account)) {
 	signIn := accountui.NewSignIn()
 	importKey := accountui.NewImportKey()
+	openKeyFile := accountui.NewOpenKeyFile(f.window)
+	importKeyCodes := accountui.NewImportKeyCodes()
 	signUp := accountui.NewSignUp()
+	factories := f.accountFactories()
+	var algorithms []string
+	for _, factory := range factories {
+		algorithms = append(algorithms, factory.Name())
+	}
+	signUp.SetAlgorithms(algorithms)
+	f.lock.Lock()
+	if p := f.passwordPolicy; p != nil {
+		signUp.Policy = p
+	}
+	f.lock.Unlock()
 	accordion := widget.NewAccordion(
 		&widget.AccordionItem{Title: "Sign In", Detail: signIn.CanvasObject(), Open: true},
 		widget.NewAccordionItem("Import Keys", importKey.CanvasObject()),
+		widget.NewAccordionItem("Open Key File", openKeyFile.CanvasObject()),
+		widget.NewAccordionItem("Import QR Codes", importKeyCodes.CanvasObject()),
 		widget.NewAccordionItem("Sign Up", signUp.CanvasObject()),
 	)
 	tos := &widget.Hyperlink{Text: "Terms of Service"}
@@ -285,7 +620,11 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 	importKeyAction := func() {
 		d.Hide()
 
-		host := bcgo.BCWebsite()
+		host, err := storage.ParseKeyHost(importKey.Host.Text)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
 		alias := importKey.Alias.Text
 		access := importKey.Access.Text
 
@@ -293,7 +632,7 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 		progress := dialog.NewProgress("Importing Keys", fmt.Sprintf("Importing %s from %s", alias, host), f.window)
 		progress.Show()
 
-		err := client.ImportKeys(host, alias, access)
+		err = storage.ImportKeys(f.app.Preferences(), client, host, alias, access)
 
 		// Hide Progress Dialog
 		progress.Hide()
@@ -343,6 +682,10 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 		d.Show()
 		d.Resize(ui.DialogSize)
 	}
+	f.setKeyHosts(importKey.Host)
+	importKey.Host.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(importKey.Alias)
+	}
 	importKey.Alias.OnSubmitted = func(string) {
 		f.window.Canvas().Focus(importKey.Access)
 	}
@@ -350,56 +693,184 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 		importKeyAction()
 	}
 	importKey.ImportKeyButton.OnTapped = importKeyAction
-	signUpAction := func() {
+	openKeyFileAction := func() {
 		d.Hide()
 
-		alias := signUp.Alias.Text
-		password := []byte(signUp.Password.Text)
-		confirm := []byte(signUp.Confirm.Text)
-
-		err := aliasgo.ValidateAlias(alias)
-		if err != nil {
-			f.ShowError(err)
-			return
-		}
-
-		// TODO check Alias is Unique
-
+		alias := openKeyFile.Alias.Text
+		password := []byte(openKeyFile.Password.Text)
 		if len(password) < cryptogo.MIN_PASSWORD {
 			f.ShowError(cryptogo.ErrPasswordTooShort{Size: len(password), Min: cryptogo.MIN_PASSWORD})
 			return
 		}
-		if !bytes.Equal(password, confirm) {
-			f.ShowError(cryptogo.ErrPasswordsDoNotMatch{})
-			return
-		}
-		f.NewAccount(client, alias, password, func(account bcgo.Account) {
+		go f.openKeyFile(client, alias, openKeyFile.File.Text, password, openKeyFile.Copy.Checked, func(account bcgo.Account) {
 			if c := callback; c != nil {
 				c(account)
 			}
-			for _, c := range f.onSignedUp {
+			for _, c := range f.onSignedIn {
 				c(account)
 			}
 		})
 	}
-	signUp.Alias.OnSubmitted = func(string) {
-		f.window.Canvas().Focus(signUp.Password)
+	openKeyFile.Alias.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(openKeyFile.Password)
 	}
-	signUp.Password.OnSubmitted = func(string) {
-		f.window.Canvas().Focus(signUp.Confirm)
+	openKeyFile.Password.OnSubmitted = func(string) {
+		openKeyFileAction()
 	}
-	signUp.Confirm.OnSubmitted = func(string) {
-		signUpAction()
+	openKeyFile.OpenFileButton.OnTapped = openKeyFileAction
+	transfer := &storage.KeyTransfer{}
+	importKeyCodes.AddCodeButton.OnTapped = func() {
+		open := dialog.NewFileOpen(func(reader fyne.URIReadCloser, err error) {
+			if err != nil {
+				f.ShowError(err)
+				return
+			}
+			if reader == nil {
+				return
+			}
+			img, _, err := image.Decode(reader)
+			reader.Close()
+			if err != nil {
+				f.ShowError(err)
+				return
+			}
+			code, err := ui.DecodeQRCode(img)
+			if err != nil {
+				f.ShowError(err)
+				return
+			}
+			if err := transfer.Add(code); err != nil {
+				f.ShowError(err)
+				return
+			}
+			received, total := transfer.Count()
+			status := fmt.Sprintf("Key of %s: %d of %d QR codes added", transfer.Alias, received, total)
+			if missing := transfer.Missing(); len(missing) > 0 {
+				var parts []string
+				for _, m := range missing {
+					parts = append(parts, strconv.Itoa(m))
+				}
+				status += "\nMissing " + strings.Join(parts, ", ")
+			}
+			importKeyCodes.Status.SetText(status)
+		}, f.window)
+		// Lossy formats are not offered as the decoder does not correct errors
+		open.SetFilter(fynestorage.NewExtensionFileFilter([]string{".png", ".jpg", ".jpeg"}))
+		open.Show()
 	}
-
-	signUp.Alias.Validator = func(alias string) error {
-		if err := aliasgo.ValidateAlias(alias); err != nil {
-			return err
+	importKeyCodes.ResetButton.OnTapped = func() {
+		transfer.Reset()
+		importKeyCodes.Status.SetText("No QR codes added")
+	}
+	importKeyCodesAction := func() {
+		if !transfer.IsComplete() {
+			f.ShowError(storage.ErrKeyTransferIncomplete)
+			return
 		}
+		d.Hide()
 
-		// TODO check Alias is Unique
-
-		return nil
+		password := []byte(importKeyCodes.Password.Text)
+		if len(password) < cryptogo.MIN_PASSWORD {
+			f.ShowError(cryptogo.ErrPasswordTooShort{Size: len(password), Min: cryptogo.MIN_PASSWORD})
+			return
+		}
+		f.importKeyCodes(client, transfer, password, func(account bcgo.Account) {
+			if c := callback; c != nil {
+				c(account)
+			}
+			for _, c := range f.onSignedIn {
+				c(account)
+			}
+		})
+	}
+	importKeyCodes.Password.OnSubmitted = func(string) {
+		importKeyCodesAction()
+	}
+	importKeyCodes.ImportButton.OnTapped = importKeyCodesAction
+	signUpAction := func() {
+		d.Hide()
+
+		alias := signUp.Alias.Text
+		password := []byte(signUp.Password.Text)
+		confirm := []byte(signUp.Confirm.Text)
+
+		err := aliasgo.ValidateAlias(alias)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+
+		// TODO check Alias is Unique
+
+		if _, err := signUp.Validate(); err != nil {
+			f.ShowError(err)
+			return
+		}
+		if !bytes.Equal(password, confirm) {
+			f.ShowError(cryptogo.ErrPasswordsDoNotMatch{})
+			return
+		}
+		var factory AccountFactory
+		for _, a := range factories {
+			if a.Name() == signUp.Algorithm.Selected {
+				factory = a
+				break
+			}
+		}
+		if factory == nil {
+			f.ShowError(fmt.Errorf("Unsupported key algorithm: %s", signUp.Algorithm.Selected))
+			return
+		}
+		signedUp := func(account bcgo.Account) {
+			if c := callback; c != nil {
+				c(account)
+			}
+			for _, c := range f.onSignedUp {
+				c(account)
+			}
+		}
+		rootDir, err := client.Root()
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Get key store
+		keystore, err := bcgo.KeyDirectory(rootDir)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Key may already exist from an earlier sign up, even one interrupted before its registration was marked pending
+		if existing, err := FactoryForAlias(factories, keystore, alias); err == nil {
+			if existing != factory {
+				f.ShowError(fmt.Errorf("Keystore already holds a %s key for %s, choose %s to resume its registration", existing.Name(), alias, existing.Name()))
+				return
+			}
+			go f.resumeAccount(client, factory, alias, password, signedUp)
+			return
+		}
+		storage.SetRegistrationPending(f.app.Preferences(), alias, false)
+		// Run in background so the progress can be cancelled
+		go f.NewAccount(client, factory, alias, password, signedUp)
+	}
+	signUp.Alias.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(signUp.Password)
+	}
+	signUp.Password.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(signUp.Confirm)
+	}
+	signUp.Confirm.OnSubmitted = func(string) {
+		signUpAction()
+	}
+
+	signUp.Alias.Validator = func(alias string) error {
+		if err := aliasgo.ValidateAlias(alias); err != nil {
+			return err
+		}
+
+		// TODO check Alias is Unique
+
+		return nil
 	}
 
 	signUp.SignUpButton.OnTapped = signUpAction
@@ -412,10 +883,16 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 		if err != nil {
 			log.Println(err)
 		} else {
-			keys, err := cryptogo.ListRSAPrivateKeys(keystore)
-			if err != nil {
-				log.Println(err)
-			} else if len(keys) > 0 {
+			var keys []string
+			for _, factory := range factories {
+				aliases, err := factory.Aliases(keystore)
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+				keys = append(keys, aliases...)
+			}
+			if len(keys) > 0 {
 				signIn.Alias.SetOptions(keys)
 				signIn.Alias.SetText(keys[0])
 				importKey.Alias.SetText(keys[0])
@@ -427,6 +904,7 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 	if alias, ok := os.LookupEnv("ALIAS"); ok {
 		signIn.Alias.SetText(alias)
 		importKey.Alias.SetText(alias)
+		openKeyFile.Alias.SetText(alias)
 		signUp.Alias.SetText(alias)
 	}
 
@@ -436,7 +914,7 @@ func (f *bcFyne) ShowAccessDialog(client bcclientgo.BCClient, callback func(bcgo
 
 	if signIn.Alias.Text == "" {
 		// Make accordion show sign up as open instead of sign in
-		accordion.Open(2)
+		accordion.Open(4)
 	}
 
 	// Show Access Dialog
@@ -460,11 +938,47 @@ func (f *bcFyne) ShowAccount(client bcclientgo.BCClient) {
 		contents.Add(ui.NewTestModeSign())
 	}
 	contents.Add(form)
+	// Registration widgets are created hidden, so the check only updates them instead of changing the contents of the showing dialog
+	warning := &widget.Label{
+		TextStyle: fyne.TextStyle{Bold: true},
+		Wrapping:  fyne.TextWrapWord,
+	}
+	warning.Hide()
+	contents.Add(warning)
+	register := widget.NewButton("Register Now", nil)
+	register.Hide()
+	contents.Add(register)
 
 	d := dialog.NewCustom("Account", "OK", contents, f.window)
+	register.OnTapped = func() {
+		d.Hide()
+		go func() {
+			if err := f.showRegistration(client, account); err != nil {
+				if err != context.Canceled {
+					f.ShowError(err)
+				}
+				return
+			}
+			dialog.ShowInformation("Registered", account.Alias()+" has been registered", f.window)
+		}()
+	}
+	go f.checkRegistration(client, account, warning, register)
+	contents.Add(widget.NewButton("Change Password", func() {
+		f.showChangePassword(client, account)
+	}))
+	contents.Add(widget.NewButton("Rotate Key", func() {
+		d.Hide()
+		f.showRotateKey(client, account)
+	}))
 	contents.Add(widget.NewButton("Export Keys", func() {
 		f.ExportKeys(client, account)
 	}))
+	contents.Add(widget.NewButton("Export Keys as QR Codes", func() {
+		f.exportKeyCodes(client, account)
+	}))
+	contents.Add(widget.NewButton("Access Codes", func() {
+		f.showAccessCodes(client)
+	}))
 	contents.Add(widget.NewButton("Delete Keys", func() {
 		d.Hide()
 		f.DeleteKeys(client, account)
@@ -477,31 +991,192 @@ func (f *bcFyne) ShowAccount(client bcclientgo.BCClient) {
 	d.Resize(ui.DialogSize)
 }
 
+// checkRegistration shows the warning, and the button to register now, if the key of the account is not registered to its alias.
+func (f *bcFyne) checkRegistration(client bcclientgo.BCClient, account bcgo.Account, warning *widget.Label, register *widget.Button) {
+	alias := account.Alias()
+	_, key, err := account.PublicKey()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	cache, err := client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	network, err := client.Network()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	registered, err := storage.IsRegistered(f.KeyCodecs(), cache, network, alias, key)
+	if taken, ok := err.(storage.ErrAliasTaken); ok {
+		warning.SetText(taken.Error())
+		warning.Show()
+		return
+	} else if err != nil {
+		log.Println(err)
+		return
+	}
+	if registered {
+		storage.SetRegistrationPending(f.app.Preferences(), alias, false)
+		return
+	}
+	warning.SetText(alias + " is not registered, others cannot find this key until it is")
+	warning.Show()
+	register.Show()
+}
+
+func (f *bcFyne) ShowAliases(client bcclientgo.BCClient) {
+	directory := ui.NewAliasDirectory(f, client)
+	window := f.app.NewWindow("Aliases")
+	window.SetContent(directory.CanvasObject())
+	window.Resize(ui.WindowSize)
+	window.CenterOnScreen()
+	window.Show()
+	if err := directory.Load(); err != nil {
+		// Show whatever could be loaded from the cache
+		log.Println(err)
+	}
+}
+
+func (f *bcFyne) ShowCreateChannel(client bcclientgo.BCClient) {
+	wizard := ui.NewChannelWizard()
+	contents := container.NewVBox()
+	if !bcgo.IsLive() {
+		contents.Add(ui.NewTestModeSign())
+	}
+	contents.Add(wizard.CanvasObject())
+	d := dialog.NewCustomConfirm("New Channel", "Create", "Cancel", contents, func(ok bool) {
+		if !ok {
+			return
+		}
+		go func() {
+			if err := wizard.Validate(); err != nil {
+				f.ShowError(err)
+				return
+			}
+			node, err := f.Node(client)
+			if err != nil {
+				f.ShowError(err)
+				return
+			}
+			name := wizard.ChannelName()
+
+			// Show Progress Dialog
+			progress := dialog.NewProgress("Mining", "Mining genesis block of "+name, f.window)
+			progress.Show()
+			listener := &ui.ProgressMiningListener{Func: progress.SetValue}
+
+			// Create Channel
+			channel, err := storage.CreateChannel(node, strings.TrimSpace(wizard.Name.Text), wizard.Options(), listener)
+
+			// Hide Progress Dialog
+			progress.Hide()
+
+			if err != nil {
+				f.ShowError(err)
+				return
+			}
+			f.ShowURI(client, storage.NewChannelURI(channel.Name()))
+		}()
+	}, f.window)
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
+func (f *bcFyne) showChangePassword(client bcclientgo.BCClient, account bcgo.Account) {
+	alias := account.Alias()
+	changePassword := accountui.NewChangePassword(alias)
+	contents := container.NewVBox()
+	if !bcgo.IsLive() {
+		contents.Add(ui.NewTestModeSign())
+	}
+	contents.Add(changePassword.CanvasObject())
+	d := dialog.NewCustom("Change Password", "Cancel", contents, f.window)
+	changePasswordAction := func() {
+		password := []byte(changePassword.Password.Text)
+		newPassword := []byte(changePassword.NewPassword.Text)
+		confirm := []byte(changePassword.Confirm.Text)
+
+		if len(newPassword) < cryptogo.MIN_PASSWORD {
+			f.ShowError(cryptogo.ErrPasswordTooShort{Size: len(newPassword), Min: cryptogo.MIN_PASSWORD})
+			return
+		}
+		if !bytes.Equal(newPassword, confirm) {
+			f.ShowError(cryptogo.ErrPasswordsDoNotMatch{})
+			return
+		}
+
+		d.Hide()
+
+		rootDir, err := client.Root()
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Get key store
+		keystore, err := bcgo.KeyDirectory(rootDir)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Detect key type
+		factory, err := FactoryForAlias(f.accountFactories(), keystore, alias)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		if err := storage.ChangePassword(factory, keystore, alias, password, newPassword); err != nil {
+			f.ShowError(err)
+			return
+		}
+		dialog.ShowInformation("Password Changed", "Password of "+alias+" has been changed", f.window)
+	}
+	changePassword.Password.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(changePassword.NewPassword)
+	}
+	changePassword.NewPassword.OnSubmitted = func(string) {
+		f.window.Canvas().Focus(changePassword.Confirm)
+	}
+	changePassword.Confirm.OnSubmitted = func(string) {
+		changePasswordAction()
+	}
+	changePassword.ChangePasswordButton.OnTapped = changePasswordAction
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
 func (f *bcFyne) DeleteKeys(client bcclientgo.BCClient, account bcgo.Account) {
 	f.ShowError(fmt.Errorf("Not yet implemented: %s", "BCFyne.DeleteKeys"))
 }
 
 func (f *bcFyne) ExportKeys(client bcclientgo.BCClient, account bcgo.Account) {
 	alias := account.Alias()
+	hosts := widget.NewSelectEntry(nil)
+	hosts.PlaceHolder = "Host"
+	hosts.Wrapping = fyne.TextWrapOff
+	f.setKeyHosts(hosts)
 	authentication := accountui.NewAuthentication(alias)
 	authenticateAction := func() {
 
-		host := bcgo.BCWebsite()
+		host, err := storage.ParseKeyHost(hosts.Text)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
 
 		// Show Progress Dialog
 		progress := dialog.NewProgress("Exporting Keys", fmt.Sprintf("Exporting %s to %s", alias, host), f.window)
 		progress.Show()
 
-		var (
-			access string
-			err    error
-		)
+		var access string
 
 		password := []byte(authentication.Password.Text)
 		if len(password) < cryptogo.MIN_PASSWORD {
 			err = cryptogo.ErrPasswordTooShort{Size: len(password), Min: cryptogo.MIN_PASSWORD}
 		} else {
-			access, err = client.ExportKeys(host, alias, password)
+			access, err = storage.ExportKeys(f.app.Preferences(), client, host, alias, password)
 		}
 
 		// Hide Progress Dialog
@@ -511,23 +1186,56 @@ func (f *bcFyne) ExportKeys(client bcclientgo.BCClient, account bcgo.Account) {
 			f.ShowError(err)
 			return
 		}
+		code, err := storage.AddAccessCode(f.app.Preferences(), host, alias, access)
+		if err != nil {
+			log.Println(err)
+		}
 
+		status := widget.NewLabel(code.Status(time.Now()))
 		form := widget.NewForm(
+			widget.NewFormItem("Host", widget.NewLabel(host)),
 			widget.NewFormItem("Alias", widget.NewLabel(alias)),
 			widget.NewFormItem("Access Code", container.NewHBox(
 				widget.NewLabel(access),
 				widget.NewButtonWithIcon("", theme.ContentCopyIcon(), func() {
-					f.window.Clipboard().SetContent(access)
-					dialog.ShowInformation("Copied", "Access code copied to clipboard", f.window)
+					timeout := storage.ClipboardTimeout(f.app.Preferences())
+					ui.CopySecretToClipboard(f.window, access, timeout)
+					dialog.ShowInformation("Copied", fmt.Sprintf("Access code copied to clipboard, it will be cleared in %s", timeout), f.window)
 				}),
 			)),
+			widget.NewFormItem("Status", status),
 		)
 		contents := container.NewVBox()
 		if !bcgo.IsLive() {
 			contents.Add(ui.NewTestModeSign())
 		}
 		contents.Add(form)
+		// Only offer to revoke the access code if the host supports it
+		if revoker, ok := client.(storage.AccessCodeRevoker); ok && revoker.CanRevokeKeys(host) {
+			var revoke *widget.Button
+			revoke = widget.NewButton("Revoke Access Code", func() {
+				// Show Progress Dialog
+				progress := dialog.NewProgressInfinite("Revoking", fmt.Sprintf("Revoking access code for %s from %s", alias, host), f.window)
+				progress.Show()
+
+				err := storage.RevokeAccessCode(f.app.Preferences(), revoker, code, access)
+
+				// Hide Progress Dialog
+				progress.Hide()
+
+				if err != nil {
+					f.ShowError(err)
+					return
+				}
+				status.SetText(code.Status(time.Now()))
+				revoke.Disable()
+			})
+			contents.Add(revoke)
+		}
 		d := dialog.NewCustom("Keys Exported", "OK", contents, f.window)
+		ui.TickUntilClosed(d, func(now time.Time) {
+			status.SetText(code.Status(now))
+		})
 		d.Show()
 		d.Resize(ui.DialogSize)
 
@@ -540,22 +1248,358 @@ func (f *bcFyne) ExportKeys(client bcclientgo.BCClient, account bcgo.Account) {
 	}
 	authentication.AuthenticateButton.OnTapped = authenticateAction
 
+	contents := container.NewVBox()
+	if !bcgo.IsLive() {
+		contents.Add(ui.NewTestModeSign())
+	}
+	contents.Add(hosts)
+	contents.Add(authentication.CanvasObject())
+	d := dialog.NewCustom("Account", "Cancel", contents, f.window)
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
+// showAccessCodes lists the access codes exported from this device and when each expires.
+func (f *bcFyne) showAccessCodes(client bcclientgo.BCClient) {
+	preferences := f.app.Preferences()
+	list := ui.NewAccessCodeList()
+	list.OnForget = func(code *storage.AccessCode) {
+		if err := storage.RemoveAccessCode(preferences, code); err != nil {
+			f.ShowError(err)
+			return
+		}
+		list.SetCodes(storage.AccessCodes(preferences))
+	}
+	list.SetCodes(storage.AccessCodes(preferences))
+	contents := container.NewVBox()
+	if !bcgo.IsLive() {
+		contents.Add(ui.NewTestModeSign())
+	}
+	contents.Add(list)
+	d := dialog.NewCustom("Access Codes", "OK", container.NewVScroll(contents), f.window)
+	ui.TickUntilClosed(d, list.Tick)
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
+// setKeyHosts offers the hosts used before to import and export keys, selecting the default.
+func (f *bcFyne) setKeyHosts(s *widget.SelectEntry) {
+	hosts := storage.KeyHosts(f.app.Preferences())
+	s.SetOptions(hosts)
+	s.SetText(hosts[0])
+}
+
+// exportKeyCodes shows the key of the account, encrypted with its password, as a sequence of QR codes for importing on a device without network access.
+func (f *bcFyne) exportKeyCodes(client bcclientgo.BCClient, account bcgo.Account) {
+	alias := account.Alias()
+	authentication := accountui.NewAuthentication(alias)
 	contents := container.NewVBox()
 	if !bcgo.IsLive() {
 		contents.Add(ui.NewTestModeSign())
 	}
 	contents.Add(authentication.CanvasObject())
 	d := dialog.NewCustom("Account", "Cancel", contents, f.window)
+	authenticateAction := func() {
+		d.Hide()
+
+		password := []byte(authentication.Password.Text)
+		if len(password) < cryptogo.MIN_PASSWORD {
+			f.ShowError(cryptogo.ErrPasswordTooShort{Size: len(password), Min: cryptogo.MIN_PASSWORD})
+			return
+		}
+		rootDir, err := client.Root()
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Get key store
+		keystore, err := bcgo.KeyDirectory(rootDir)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Detect key type
+		factory, err := FactoryForAlias(f.accountFactories(), keystore, alias)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		// Authenticate with the current password
+		data, err := factory.Export(keystore, alias, password)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		parts := storage.EncodeKeyTransfer(alias, data)
+		codes, err := ui.NewKeyCodes(parts)
+		if err != nil {
+			f.ShowError(err)
+			return
+		}
+		contents := container.NewVBox()
+		if !bcgo.IsLive() {
+			contents.Add(ui.NewTestModeSign())
+		}
+		contents.Add(&widget.Label{
+			Text:     fmt.Sprintf("Each QR code holds part of the key of %s, encrypted with its password. Save all %d codes and import them on the other device with the same password.", alias, len(parts)),
+			Wrapping: fyne.TextWrapWord,
+		})
+		contents.Add(codes)
+		contents.Add(widget.NewButton("Save QR Codes", func() {
+			dialog.ShowFolderOpen(func(folder fyne.ListableURI, err error) {
+				if err != nil {
+					f.ShowError(err)
+					return
+				}
+				if folder == nil {
+					return
+				}
+				for i, img := range codes.Images {
+					if err := writeImage(folder, fmt.Sprintf("%s-key-%d-of-%d.png", alias, i+1, len(codes.Images)), img); err != nil {
+						f.ShowError(err)
+						return
+					}
+				}
+				dialog.ShowInformation("Saved", fmt.Sprintf("%d QR codes saved to %s", len(codes.Images), folder.Name()), f.window)
+			}, f.window)
+		}))
+		d := dialog.NewCustom("Key QR Codes", "OK", contents, f.window)
+		d.Show()
+		d.Resize(ui.DialogSize)
+
+		for _, c := range f.onKeysExported {
+			c(alias)
+		}
+	}
+	authentication.Password.OnSubmitted = func(string) {
+		authenticateAction()
+	}
+	authentication.AuthenticateButton.OnTapped = authenticateAction
 	d.Show()
 	d.Resize(ui.DialogSize)
 }
 
+func writeImage(folder fyne.URI, name string, img image.Image) error {
+	u, err := fynestorage.Child(folder, name)
+	if err != nil {
+		return err
+	}
+	writer, err := fynestorage.Writer(u)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(writer, img); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+// importKeyCodes writes the key reassembled from QR codes into the keystore and signs in, without network access so keys can be moved to an air-gapped device.
+func (f *bcFyne) importKeyCodes(client bcclientgo.BCClient, transfer *storage.KeyTransfer, password []byte, callback func(bcgo.Account)) {
+	alias := transfer.Alias
+	data, err := transfer.Data()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	// Detect key type
+	factory, _, err := FactoryForKeyFile(f.accountFactories(), alias, data, password)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	rootDir, err := client.Root()
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	// Get key store
+	keystore, err := bcgo.KeyDirectory(rootDir)
+	if err != nil {
+		f.ShowError(err)
+		return
+	}
+	if _, err := FactoryForAlias(f.accountFactories(), keystore, alias); err == nil {
+		f.ShowError(fmt.Errorf("Keystore already holds a key for %s", alias))
+		return
+	}
+	if err := factory.Import(keystore, alias, data, password); err != nil {
+		f.ShowError(err)
+		return
+	}
+	for _, c := range f.onKeysImported {
+		c(alias)
+	}
+	f.ExistingAccount(client, alias, password, callback)
+}
+
+func (f *bcFyne) showRotateKey(client bcclientgo.BCClient, previous bcgo.Account) {
+	alias := previous.Alias()
+	authentication := accountui.NewAuthentication(alias)
+	contents := container.NewVBox()
+	if !bcgo.IsLive() {
+		contents.Add(ui.NewTestModeSign())
+	}
+	contents.Add(&widget.Label{
+		Text:     "A new key will replace the key of " + alias + ". The rotation is signed with the current key and published so others can follow it. The current key is archived on this device so records already shared with it can still be read.",
+		Wrapping: fyne.TextWrapWord,
+	})
+	contents.Add(authentication.CanvasObject())
+	d := dialog.NewCustom("Rotate Key", "Cancel", contents, f.window)
+	authenticateAction := func() {
+		password := []byte(authentication.Password.Text)
+		d.Hide()
+		go func() {
+			next, pushErr, err := f.rotateKey(client, previous, password)
+			if next == nil {
+				f.ShowError(err)
+				return
+			}
+			// The rotation is mined so the new key is used even if it could not replace the current key in the keystore
+			client.SetAccount(next)
+			client.SetNode(nil)
+			if err != nil {
+				f.ShowError(err)
+			}
+			if pushErr != nil {
+				dialog.ShowConfirm("Push Failed", pushErr.Error()+"\nRetry the push now?", func(retry bool) {
+					if retry {
+						ui.ShowSync(f, client, storage.ALIAS_ROTATION, true, f.window, nil)
+					}
+				}, f.window)
+				return
+			}
+			if err == nil {
+				dialog.ShowInformation("Key Rotated", "Key of "+alias+" has been rotated", f.window)
+			}
+		}()
+	}
+	authentication.Password.OnSubmitted = func(string) {
+		authenticateAction()
+	}
+	authentication.AuthenticateButton.OnTapped = authenticateAction
+	d.Show()
+	d.Resize(ui.DialogSize)
+}
+
+// ROTATION_COMMIT_RETRIES is the number of times the new key is retried after failing to replace the current key of a mined rotation.
+const ROTATION_COMMIT_RETRIES = 3
+
+// rotateKey generates a new key for the alias, archives the current key, and mines the rotation before replacing the key in the keystore.
+// Once the rotation is mined the new account is returned, along with the error from pushing the rotation,
+// and an error if the new key could not replace the current key.
+func (f *bcFyne) rotateKey(client bcclientgo.BCClient, previous bcgo.Account, password []byte) (bcgo.Account, error, error) {
+	alias := previous.Alias()
+	rootDir, err := client.Root()
+	if err != nil {
+		return nil, nil, err
+	}
+	// Get key store
+	keystore, err := bcgo.KeyDirectory(rootDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Detect key type
+	factory, err := FactoryForAlias(f.accountFactories(), keystore, alias)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Authenticate with the current password
+	if _, err := factory.Load(keystore, alias, password); err != nil {
+		return nil, nil, err
+	}
+	node, err := f.Node(client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Show Progress Dialog
+	progress := dialog.NewProgressInfinite("Generating", "Generating new key for "+alias, f.window)
+	progress.Show()
+
+	// Create private key of the same algorithm as the current key
+	var next bcgo.Account
+	staged, err := storage.StageKey(factory, keystore, alias, password, func(directory string) (err error) {
+		next, err = factory.Create(context.Background(), directory, alias, password)
+		return
+	})
+
+	// Hide Progress Dialog
+	progress.Hide()
+
+	if err != nil {
+		return nil, nil, err
+	}
+	// Archive current key before anything is published
+	archive, err := factory.Archive(keystore, alias, password)
+	if err != nil {
+		staged.Discard()
+		return nil, nil, err
+	}
+
+	var reference *bcgo.Reference
+	var pushErr error
+	{
+		// Show Progress Dialog
+		progress := dialog.NewProgress("Rotating", "Publishing new key of "+alias, f.window)
+		progress.Show()
+		listener := &ui.ProgressMiningListener{Func: progress.SetValue}
+
+		// Publish Rotation
+		reference, pushErr = storage.RotateKey(node, next, listener)
+
+		// Hide Progress Dialog
+		progress.Hide()
+	}
+	if reference == nil {
+		// Nothing was mined so the current key is still in use
+		staged.Discard()
+		if err := os.RemoveAll(archive); err != nil {
+			log.Println(err)
+		}
+		return nil, nil, pushErr
+	}
+	// The current key is now archived, and records shared with it can be read
+	if err := f.loadPrevious(factory, keystore, alias, password); err != nil {
+		log.Println(err)
+	}
+	// The rotation is mined so the new key must not be lost, retry before giving up
+	for i := 0; ; i++ {
+		err := staged.Commit(keystore)
+		if err == nil {
+			break
+		}
+		if i >= ROTATION_COMMIT_RETRIES {
+			return next, pushErr, fmt.Errorf("Rotation of %s was mined, but the new key could not replace the current key in the keystore and is kept at %s until moved there by hand: %s", alias, staged.Path(), err)
+		}
+		log.Println(err)
+		time.Sleep(time.Second)
+	}
+	return next, pushErr, nil
+}
+
+// loadPrevious replaces the previous accounts with the keys of the alias archived by rotation.
+func (f *bcFyne) loadPrevious(codec storage.KeyCodec, keystore, alias string, password []byte) error {
+	previous, err := storage.ArchivedKeys(codec, keystore, alias, password)
+	f.lock.Lock()
+	f.previous = previous
+	f.lock.Unlock()
+	return err
+}
+
 func (f *bcFyne) SignOut(client bcclientgo.BCClient) {
 	client.SetRoot("")
 	client.SetCache(nil)
 	client.SetNetwork(nil)
 	client.SetAccount(nil)
 	client.SetNode(nil)
+	f.lock.Lock()
+	f.index = nil
+	f.contacts = nil
+	f.inbox = nil
+	f.previous = nil
+	f.lock.Unlock()
 	for _, c := range f.onSignedOut {
 		c()
 	}
@@ -567,10 +1611,29 @@ func (f *bcFyne) ShowError(err error) {
 	dialog.ShowError(err, f.window)
 }
 
+func (f *bcFyne) ShowInbox(client bcclientgo.BCClient) {
+	if _, err := f.Inbox(client); err != nil {
+		f.ShowError(err)
+		return
+	}
+	inbox := ui.NewInboxView(f, client)
+	window := f.app.NewWindow("Shared With Me")
+	window.SetContent(container.NewVScroll(inbox))
+	window.Resize(ui.WindowSize)
+	window.CenterOnScreen()
+	window.Show()
+	inbox.Update()
+	inbox.Scan()
+}
+
 func (f *bcFyne) ShowURI(client bcclientgo.BCClient, uri fyne.URI) {
 	var view fyne.CanvasObject
 	switch u := uri.(type) {
 	case storage.AliasURI:
+		if u.Alias() == "" {
+			f.ShowAliases(client)
+			return
+		}
 		av := ui.NewAliasView(f, client)
 		av.SetURI(u)
 		view = av
@@ -619,8 +1682,6 @@ func identityView(identity bcgo.Identity) (fyne.CanvasObject, error) {
 	}
 
 	aliasScroller := container.NewHScroll(ui.NewAliasLabel(identity.Alias()))
-	bytesScroller := container.NewVScroll(ui.NewKeyLabel(bytes))
-	bytesScroller.SetMinSize(fyne.NewSize(0, 10*theme.TextSize())) // Show at least 10 lines
 	formatScroller := container.NewVScroll(widget.NewLabel(format.String()))
 
 	return widget.NewForm(
@@ -630,7 +1691,7 @@ func identityView(identity bcgo.Identity) (fyne.CanvasObject, error) {
 		),
 		widget.NewFormItem(
 			"Public Key",
-			bytesScroller,
+			ui.NewKeyLabel(bytes),
 		),
 		widget.NewFormItem(
 			"Public Key Format",
@@ -8,4 +8,8 @@ require (
 	aletheiaware.com/bcgo v1.2.3
 	aletheiaware.com/cryptogo v1.2.2
 	fyne.io/fyne/v2 v2.0.2
+	github.com/golang/protobuf v1.5.2
+	github.com/makiuchi-d/gozxing v0.1.1
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+	golang.org/x/crypto v0.0.0-20210415154028-4f45737414dc
 )
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"encoding/json"
+	"fyne.io/fyne/v2"
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	PREFERENCE_ACCESS_CODES         = "access_codes"
+	PREFERENCE_ACCESS_CODE_VALIDITY = "access_code_validity"
+	PREFERENCE_CLIPBOARD_TIMEOUT    = "clipboard_timeout"
+
+	// DEFAULT_ACCESS_CODE_VALIDITY is the number of minutes a host is assumed to hold exported keys.
+	DEFAULT_ACCESS_CODE_VALIDITY = 10
+	// DEFAULT_CLIPBOARD_TIMEOUT is the number of seconds before a copied access code is cleared from the clipboard.
+	DEFAULT_CLIPBOARD_TIMEOUT = 60
+	// ACCESS_CODE_MASK_LENGTH is the number of characters shown at each end of a masked access code.
+	ACCESS_CODE_MASK_LENGTH = 4
+)
+
+// AccessCodeRevoker is implemented by clients able to revoke exported keys before they expire.
+type AccessCodeRevoker interface {
+	// CanRevokeKeys returns true if the host advertises support for revoking keys.
+	CanRevokeKeys(host string) bool
+	RevokeKeys(host, alias, accessCode string) error
+}
+
+// AccessCode records keys exported to a host so they can be tracked until they expire.
+// Only a masked form of the access code is kept, which identifies it to the user.
+type AccessCode struct {
+	Host    string    `json:"host"`
+	Alias   string    `json:"alias"`
+	Masked  string    `json:"masked"`
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires"`
+	Revoked bool      `json:"revoked,omitempty"`
+}
+
+// Remaining returns how long the code remains valid, or zero if it has expired or been revoked.
+func (c *AccessCode) Remaining(now time.Time) time.Duration {
+	if c.Revoked || !now.Before(c.Expires) {
+		return 0
+	}
+	return c.Expires.Sub(now)
+}
+
+// Status describes when the code expires.
+func (c *AccessCode) Status(now time.Time) string {
+	if c.Revoked {
+		return "Revoked"
+	}
+	if c.Remaining(now) == 0 {
+		return "Expired"
+	}
+	return "Expires at " + c.Expires.Format("15:04:05")
+}
+
+// Matches returns true if both records are of the same export.
+func (c *AccessCode) Matches(code *AccessCode) bool {
+	return c.Host == code.Host && c.Alias == code.Alias && c.Created.Equal(code.Created)
+}
+
+// MaskAccessCode returns the access code with all but the characters at each end hidden.
+func MaskAccessCode(code string) string {
+	if len(code) <= 2*ACCESS_CODE_MASK_LENGTH {
+		return "…"
+	}
+	return code[:ACCESS_CODE_MASK_LENGTH] + "…" + code[len(code)-ACCESS_CODE_MASK_LENGTH:]
+}
+
+// AccessCodes returns the exported access codes which have not expired, newest first.
+// Expired codes are dropped from the preferences as they are found.
+func AccessCodes(p fyne.Preferences) []*AccessCode {
+	var all []*AccessCode
+	if s := p.String(PREFERENCE_ACCESS_CODES); s != "" {
+		if err := json.Unmarshal([]byte(s), &all); err != nil {
+			log.Println(err)
+		}
+	}
+	now := time.Now()
+	var codes []*AccessCode
+	for _, c := range all {
+		if now.Before(c.Expires) {
+			codes = append(codes, c)
+		}
+	}
+	if len(codes) != len(all) {
+		if err := SetAccessCodes(p, codes); err != nil {
+			log.Println(err)
+		}
+	}
+	sort.SliceStable(codes, func(i, j int) bool {
+		return codes[i].Created.After(codes[j].Created)
+	})
+	return codes
+}
+
+// SetAccessCodes replaces the exported access codes.
+func SetAccessCodes(p fyne.Preferences, codes []*AccessCode) error {
+	data, err := json.Marshal(codes)
+	if err != nil {
+		return err
+	}
+	p.SetString(PREFERENCE_ACCESS_CODES, string(data))
+	return nil
+}
+
+// AddAccessCode records an access code exported now to the host, valid for the configured period.
+// The access code itself is not recorded, only its masked form.
+func AddAccessCode(p fyne.Preferences, host, alias, code string) (*AccessCode, error) {
+	now := time.Now()
+	c := &AccessCode{
+		Host:    host,
+		Alias:   alias,
+		Masked:  MaskAccessCode(code),
+		Created: now,
+		Expires: now.Add(AccessCodeValidity(p)),
+	}
+	return c, SetAccessCodes(p, append([]*AccessCode{c}, AccessCodes(p)...))
+}
+
+// RevokeAccessCode asks the host to discard the keys exported with the access code, and records that it has been revoked.
+func RevokeAccessCode(p fyne.Preferences, revoker AccessCodeRevoker, code *AccessCode, accessCode string) error {
+	if err := revoker.RevokeKeys(code.Host, code.Alias, accessCode); err != nil {
+		return err
+	}
+	return MarkAccessCodeRevoked(p, code)
+}
+
+// MarkAccessCodeRevoked records that the access code has been revoked.
+func MarkAccessCodeRevoked(p fyne.Preferences, code *AccessCode) error {
+	codes := AccessCodes(p)
+	for _, c := range codes {
+		if c.Matches(code) {
+			c.Revoked = true
+		}
+	}
+	code.Revoked = true
+	return SetAccessCodes(p, codes)
+}
+
+// RemoveAccessCode forgets the access code.
+func RemoveAccessCode(p fyne.Preferences, code *AccessCode) error {
+	var codes []*AccessCode
+	for _, c := range AccessCodes(p) {
+		if !c.Matches(code) {
+			codes = append(codes, c)
+		}
+	}
+	return SetAccessCodes(p, codes)
+}
+
+// AccessCodeValidity returns how long a host is assumed to hold exported keys.
+func AccessCodeValidity(p fyne.Preferences) time.Duration {
+	return time.Duration(p.IntWithFallback(PREFERENCE_ACCESS_CODE_VALIDITY, DEFAULT_ACCESS_CODE_VALIDITY)) * time.Minute
+}
+
+// SetAccessCodeValidity sets the number of minutes a host is assumed to hold exported keys.
+func SetAccessCodeValidity(p fyne.Preferences, minutes int) {
+	p.SetInt(PREFERENCE_ACCESS_CODE_VALIDITY, minutes)
+}
+
+// ClipboardTimeout returns the time before a copied access code is cleared from the clipboard.
+func ClipboardTimeout(p fyne.Preferences) time.Duration {
+	return time.Duration(p.IntWithFallback(PREFERENCE_CLIPBOARD_TIMEOUT, DEFAULT_CLIPBOARD_TIMEOUT)) * time.Second
+}
+
+// SetClipboardTimeout sets the number of seconds before a copied access code is cleared from the clipboard.
+func SetClipboardTimeout(p fyne.Preferences, seconds int) {
+	p.SetInt(PREFERENCE_CLIPBOARD_TIMEOUT, seconds)
+}
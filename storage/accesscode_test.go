@@ -0,0 +1,179 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"errors"
+	"fyne.io/fyne/v2/test"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_AccessCode_Status(t *testing.T) {
+	now := time.Date(2021, time.March, 4, 15, 4, 5, 0, time.Local)
+	code := &storage.AccessCode{
+		Created: now,
+		Expires: now.Add(10 * time.Minute),
+	}
+	if got, want := code.Status(now.Add(90*time.Second)), "Expires at 15:14:05"; got != want {
+		t.Fatalf("Incorrect status; expected '%s', got '%s'", want, got)
+	}
+	if got, want := code.Status(now.Add(10*time.Minute)), "Expired"; got != want {
+		t.Fatalf("Incorrect status; expected '%s', got '%s'", want, got)
+	}
+	code.Revoked = true
+	if got, want := code.Status(now), "Revoked"; got != want {
+		t.Fatalf("Incorrect status; expected '%s', got '%s'", want, got)
+	}
+}
+
+func Test_AccessCodes(t *testing.T) {
+	p := test.NewApp().Preferences()
+	storage.SetAccessCodeValidity(p, 5)
+	first, err := storage.AddAccessCode(p, "https://a.example.com", "Alice", "abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := first.Expires.Sub(first.Created), 5*time.Minute; got != want {
+		t.Fatalf("Incorrect validity; expected %s, got %s", want, got)
+	}
+	second, err := storage.AddAccessCode(p, "https://b.example.com", "Alice", "0123456789012345678901234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := storage.AccessCodes(p)
+	if len(codes) != 2 || !codes[0].Matches(second) || !codes[1].Matches(first) {
+		t.Fatalf("Incorrect codes; expected newest first, got %v", codes)
+	}
+	if err := storage.MarkAccessCodeRevoked(p, first); err != nil {
+		t.Fatal(err)
+	}
+	codes = storage.AccessCodes(p)
+	if codes[0].Revoked || !codes[1].Revoked {
+		t.Fatal("Incorrect code revoked")
+	}
+	if err := storage.RemoveAccessCode(p, second); err != nil {
+		t.Fatal(err)
+	}
+	codes = storage.AccessCodes(p)
+	if len(codes) != 1 || !codes[0].Matches(first) {
+		t.Fatalf("Incorrect codes; expected only first, got %v", codes)
+	}
+}
+
+func Test_AccessCodes_Masked(t *testing.T) {
+	p := test.NewApp().Preferences()
+	code := "abcdefghijklmnopqrstuvwxyz"
+	c, err := storage.AddAccessCode(p, "https://a.example.com", "Alice", code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.Masked, "abcd…wxyz"; got != want {
+		t.Fatalf("Incorrect masked code; expected '%s', got '%s'", want, got)
+	}
+	if strings.Contains(p.String(storage.PREFERENCE_ACCESS_CODES), code) {
+		t.Fatal("Expected access code to not be stored")
+	}
+	if got, want := storage.MaskAccessCode("short"), "…"; got != want {
+		t.Fatalf("Incorrect masked code; expected '%s', got '%s'", want, got)
+	}
+}
+
+func Test_AccessCodes_Expired(t *testing.T) {
+	p := test.NewApp().Preferences()
+	now := time.Now()
+	if err := storage.SetAccessCodes(p, []*storage.AccessCode{
+		{
+			Host:    "https://expired.example.com",
+			Alias:   "Alice",
+			Created: now.Add(-time.Hour),
+			Expires: now.Add(-time.Minute),
+		},
+		{
+			Host:    "https://valid.example.com",
+			Alias:   "Alice",
+			Created: now,
+			Expires: now.Add(time.Minute),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	codes := storage.AccessCodes(p)
+	if len(codes) != 1 || codes[0].Host != "https://valid.example.com" {
+		t.Fatalf("Incorrect codes; expected only valid, got %v", codes)
+	}
+	if strings.Contains(p.String(storage.PREFERENCE_ACCESS_CODES), "expired") {
+		t.Fatal("Expected expired code to be dropped from preferences")
+	}
+}
+
+// revoker records the keys it is asked to revoke.
+type revoker struct {
+	hosts   map[string]bool
+	revoked []string
+}
+
+func (r *revoker) CanRevokeKeys(host string) bool {
+	return r.hosts[host]
+}
+
+func (r *revoker) RevokeKeys(host, alias, accessCode string) error {
+	if !r.hosts[host] {
+		return errors.New("Revoke unsupported")
+	}
+	r.revoked = append(r.revoked, host+" "+alias+" "+accessCode)
+	return nil
+}
+
+func Test_RevokeAccessCode(t *testing.T) {
+	p := test.NewApp().Preferences()
+	r := &revoker{
+		hosts: map[string]bool{
+			"https://a.example.com": true,
+		},
+	}
+	t.Run("Revoked", func(t *testing.T) {
+		code, err := storage.AddAccessCode(p, "https://a.example.com", "Alice", "abcdefghijklmnopqrstuvwxyz")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := storage.RevokeAccessCode(p, r, code, "abcdefghijklmnopqrstuvwxyz"); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := r.revoked, []string{"https://a.example.com Alice abcdefghijklmnopqrstuvwxyz"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect revocations; expected %v, got %v", want, got)
+		}
+		if codes := storage.AccessCodes(p); !codes[0].Revoked {
+			t.Fatal("Expected code to be revoked")
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		code, err := storage.AddAccessCode(p, "https://b.example.com", "Alice", "0123456789012345678901234")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := storage.RevokeAccessCode(p, r, code, "0123456789012345678901234"); err == nil {
+			t.Fatal("Expected error")
+		}
+		if codes := storage.AccessCodes(p); codes[0].Revoked {
+			t.Fatal("Expected code to not be revoked")
+		}
+	})
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/channel"
+	"aletheiaware.com/bcgo/validation"
+	"fmt"
+	"strconv"
+)
+
+const (
+	TEST_CHANNEL_PREFIX = "Test-"
+
+	META_THRESHOLD = "threshold"
+	META_PERIODIC  = "periodic"
+)
+
+// ErrChannelExists is returned when creating a channel that already has a head.
+type ErrChannelExists struct {
+	Channel string
+}
+
+func (e ErrChannelExists) Error() string {
+	return fmt.Sprintf("Channel already exists: %s", e.Channel)
+}
+
+// ChannelOptions configures the validation of a new channel.
+type ChannelOptions struct {
+	// Threshold is the minimum number of ones in the hash of each block.
+	Threshold uint64
+	// Periodic adds a validator which checks the channel against the periodic validation chains.
+	Periodic bool
+	// LivePrefix prefixes the channel name when not running live, keeping test channels apart.
+	LivePrefix bool
+}
+
+// DefaultChannelOptions returns the options used when none are given.
+func DefaultChannelOptions() *ChannelOptions {
+	return &ChannelOptions{
+		Threshold: bcgo.THRESHOLD_G,
+	}
+}
+
+// ChannelName returns the name the channel will be created with.
+func (o *ChannelOptions) ChannelName(name string) string {
+	if o.LivePrefix && !bcgo.IsLive() {
+		return TEST_CHANNEL_PREFIX + name
+	}
+	return name
+}
+
+// CreateChannel validates the name, mines a genesis block holding the options, and returns the new channel opened in the node.
+func CreateChannel(node bcgo.Node, name string, options *ChannelOptions, listener bcgo.MiningListener) (bcgo.Channel, error) {
+	if options == nil {
+		options = DefaultChannelOptions()
+	}
+	name = options.ChannelName(name)
+	if err := bcgo.ValidateName(name); err != nil {
+		return nil, err
+	}
+	cache := node.Cache()
+	network := node.Network()
+	if head, err := bcgo.LoadHead(name, cache, network); err == nil && head != nil {
+		return nil, ErrChannelExists{
+			Channel: name,
+		}
+	}
+	c, err := node.OpenChannel(name, func() bcgo.Channel {
+		c := channel.New(name)
+		c.AddValidator(validation.NewPoW(options.Threshold))
+		if options.Periodic {
+			c.AddValidator(validation.NewPeriodic(node, c, options.Threshold))
+		}
+		return c
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Genesis record describes how the channel is validated
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), node.Account(), nil, nil, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+	record.Meta = map[string]string{
+		META_THRESHOLD: strconv.FormatUint(options.Threshold, 10),
+		META_PERIODIC:  strconv.FormatBool(options.Periodic),
+	}
+	if _, err := bcgo.WriteRecord(name, cache, record); err != nil {
+		return nil, err
+	}
+	if _, _, err := node.Mine(c, options.Threshold, listener); err != nil {
+		return nil, err
+	}
+	if network != nil {
+		if err := c.Push(cache, network); err != nil {
+			// Ignored
+		}
+	}
+	return c, nil
+}
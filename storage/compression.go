@@ -0,0 +1,103 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// cryptogo.CompressionAlgorithm only defines UNKNOWN_COMPRESSION, so payload compression is named in the record metadata instead.
+const (
+	META_COMPRESSION = "compression"
+
+	COMPRESSION_GZIP = "GZIP"
+	COMPRESSION_ZLIB = "ZLIB"
+)
+
+// Compressions lists the supported compression algorithms.
+var Compressions = []string{
+	COMPRESSION_GZIP,
+	COMPRESSION_ZLIB,
+}
+
+// ErrUnsupportedCompression is returned when a payload is compressed with an unknown algorithm.
+type ErrUnsupportedCompression struct {
+	Algorithm string
+}
+
+func (e ErrUnsupportedCompression) Error() string {
+	return fmt.Sprintf("Unsupported compression: %s", e.Algorithm)
+}
+
+// Compress compresses the data with the given algorithm.
+func Compress(algorithm string, data []byte) ([]byte, error) {
+	var (
+		buffer bytes.Buffer
+		writer io.WriteCloser
+	)
+	switch algorithm {
+	case COMPRESSION_GZIP:
+		writer = gzip.NewWriter(&buffer)
+	case COMPRESSION_ZLIB:
+		writer = zlib.NewWriter(&buffer)
+	default:
+		return nil, ErrUnsupportedCompression{
+			Algorithm: algorithm,
+		}
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Decompress decompresses the decrypted payload of the given record with the algorithm named in its metadata.
+// The payload is returned unchanged if the record is not compressed.
+func Decompress(record *bcgo.Record, payload []byte) ([]byte, error) {
+	algorithm, ok := record.Meta[META_COMPRESSION]
+	if !ok {
+		return payload, nil
+	}
+	var (
+		reader io.ReadCloser
+		err    error
+	)
+	switch algorithm {
+	case COMPRESSION_GZIP:
+		reader, err = gzip.NewReader(bytes.NewReader(payload))
+	case COMPRESSION_ZLIB:
+		reader, err = zlib.NewReader(bytes.NewReader(payload))
+	default:
+		return nil, ErrUnsupportedCompression{
+			Algorithm: algorithm,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"testing"
+)
+
+func Test_Compression(t *testing.T) {
+	data := bytes.Repeat([]byte("Hello World "), 100)
+	for _, algorithm := range storage.Compressions {
+		t.Run(algorithm, func(t *testing.T) {
+			compressed, err := storage.Compress(algorithm, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(compressed) >= len(data) {
+				t.Fatalf("Expected compression; %d >= %d", len(compressed), len(data))
+			}
+			record := &bcgo.Record{
+				Meta: map[string]string{
+					storage.META_COMPRESSION: algorithm,
+				},
+			}
+			got, err := storage.Decompress(record, compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Fatalf("Incorrect payload; expected '%s', got '%s'", data, got)
+			}
+		})
+	}
+	t.Run("Uncompressed", func(t *testing.T) {
+		got, err := storage.Decompress(&bcgo.Record{}, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("Incorrect payload; expected '%s', got '%s'", data, got)
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		if _, err := storage.Compress("LZMA", data); err == nil {
+			t.Fatal("Expected error")
+		}
+		record := &bcgo.Record{
+			Meta: map[string]string{
+				storage.META_COMPRESSION: "LZMA",
+			},
+		}
+		if _, err := storage.Decompress(record, data); err == nil {
+			t.Fatal("Expected error")
+		}
+	})
+}
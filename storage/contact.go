@@ -0,0 +1,166 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const CONTACT_BOOK_FILE = "contacts.json"
+
+// ErrKeyChanged is returned when an alias resolves to a different key than the one pinned in the contact book.
+type ErrKeyChanged struct {
+	Alias           string
+	Pinned, Current []byte
+}
+
+func (e ErrKeyChanged) Error() string {
+	return fmt.Sprintf("Key of %s has changed since it was first seen", e.Alias)
+}
+
+// Contact records the key of an alias as it was first seen, along with local nickname and notes.
+type Contact struct {
+	Alias    string `json:"alias"`
+	KeyHash  []byte `json:"key_hash"`
+	Pinned   uint64 `json:"pinned"`
+	Nickname string `json:"nickname,omitempty"`
+	Notes    string `json:"notes,omitempty"`
+}
+
+// ContactBook pins the key of each alias on first use.
+type ContactBook interface {
+	// Contact returns the contact for the given alias, or nil if the alias has never been seen.
+	Contact(alias string) *Contact
+	// Contacts returns all contacts sorted by alias.
+	Contacts() []*Contact
+	// Check pins the key on first sight, and returns ErrKeyChanged if it differs from the pinned key.
+	Check(alias string, key []byte) error
+	// Pin replaces the pinned key of the given alias.
+	Pin(alias string, key []byte) error
+	// Update sets the nickname and notes of the given alias.
+	Update(alias, nickname, notes string) error
+}
+
+type contactBook struct {
+	sync.Mutex
+	file    string
+	Entries map[string]*Contact `json:"contacts"`
+}
+
+// NewContactBook returns a ContactBook persisted in the given directory.
+func NewContactBook(directory string) (ContactBook, error) {
+	b := &contactBook{
+		file:    filepath.Join(directory, CONTACT_BOOK_FILE),
+		Entries: make(map[string]*Contact),
+	}
+	if err := readJSON(b.file, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// FollowsRotation returns true if the pinned key hash is of a key in the history of an alias,
+// so the current key was reached from the pinned key by rotations each signed with the key before.
+func FollowsRotation(pinned []byte, history []*KeyVersion) bool {
+	for _, v := range history {
+		hash := sha256.Sum256(v.PublicKey)
+		if bytes.Equal(pinned, hash[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *contactBook) Contact(alias string) *Contact {
+	b.Lock()
+	defer b.Unlock()
+	return b.Entries[alias]
+}
+
+func (b *contactBook) Contacts() []*Contact {
+	b.Lock()
+	defer b.Unlock()
+	var contacts []*Contact
+	for _, c := range b.Entries {
+		contacts = append(contacts, c)
+	}
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].Alias < contacts[j].Alias
+	})
+	return contacts
+}
+
+func (b *contactBook) Check(alias string, key []byte) error {
+	hash := sha256.Sum256(key)
+	b.Lock()
+	defer b.Unlock()
+	c, ok := b.Entries[alias]
+	if !ok {
+		b.Entries[alias] = &Contact{
+			Alias:   alias,
+			KeyHash: hash[:],
+			Pinned:  bcgo.Timestamp(),
+		}
+		return b.save()
+	}
+	if !bytes.Equal(c.KeyHash, hash[:]) {
+		return ErrKeyChanged{
+			Alias:   alias,
+			Pinned:  c.KeyHash,
+			Current: hash[:],
+		}
+	}
+	return nil
+}
+
+func (b *contactBook) Pin(alias string, key []byte) error {
+	hash := sha256.Sum256(key)
+	b.Lock()
+	defer b.Unlock()
+	c, ok := b.Entries[alias]
+	if !ok {
+		c = &Contact{
+			Alias: alias,
+		}
+		b.Entries[alias] = c
+	}
+	c.KeyHash = hash[:]
+	c.Pinned = bcgo.Timestamp()
+	return b.save()
+}
+
+func (b *contactBook) Update(alias, nickname, notes string) error {
+	b.Lock()
+	defer b.Unlock()
+	c, ok := b.Entries[alias]
+	if !ok {
+		return fmt.Errorf("Contact not found: %s", alias)
+	}
+	c.Nickname = nickname
+	c.Notes = notes
+	return b.save()
+}
+
+func (b *contactBook) save() error {
+	return writeJSON(b.file, b)
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"os"
+	"testing"
+)
+
+func Test_ContactBook_Check(t *testing.T) {
+	dir := makeDirectory(t)
+	defer os.RemoveAll(dir)
+	book, err := storage.NewContactBook(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// First sight pins the key
+	if err := book.Check("Alice", []byte("key1")); err != nil {
+		t.Fatal(err)
+	}
+	if book.Contact("Alice") == nil {
+		t.Fatal("Expected key to be pinned")
+	}
+	if err := book.Check("Alice", []byte("key1")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := book.Check("Alice", []byte("key2")).(storage.ErrKeyChanged); !ok {
+		t.Fatal("Expected ErrKeyChanged")
+	}
+	// Pins survive reloading
+	loaded, err := storage.NewContactBook(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := loaded.Check("Alice", []byte("key2")).(storage.ErrKeyChanged); !ok {
+		t.Fatal("Expected ErrKeyChanged")
+	}
+	if err := loaded.Pin("Alice", []byte("key2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := loaded.Check("Alice", []byte("key2")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_FollowsRotation(t *testing.T) {
+	history := []*storage.KeyVersion{
+		{PublicKey: []byte("key1")},
+		{PublicKey: []byte("key2")},
+	}
+	dir := makeDirectory(t)
+	defer os.RemoveAll(dir)
+	book, err := storage.NewContactBook(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := book.Check("Alice", []byte("key1")); err != nil {
+		t.Fatal(err)
+	}
+	err = book.Check("Alice", []byte("key2"))
+	changed, ok := err.(storage.ErrKeyChanged)
+	if !ok {
+		t.Fatalf("Incorrect error; expected ErrKeyChanged, got '%v'", err)
+	}
+	if !storage.FollowsRotation(changed.Pinned, history) {
+		t.Fatal("Expected rotation from pinned key to be followed")
+	}
+	if storage.FollowsRotation(changed.Pinned, history[1:]) {
+		t.Fatal("Expected history without pinned key not to be followed")
+	}
+}
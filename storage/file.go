@@ -0,0 +1,78 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// CACHE_CHANNEL_DIRECTORY is the directory of the file cache holding a head file for each cached channel.
+const CACHE_CHANNEL_DIRECTORY = "channel"
+
+// readJSON decodes the given file into v, leaving v unchanged if the file does not exist.
+func readJSON(file string, v interface{}) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeJSON encodes v into the given file, creating its directory if necessary.
+func writeJSON(file string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0600)
+}
+
+// CachedChannels returns the names of the channels with a head in the given cache directory, sorted by name.
+func CachedChannels(cacheDir string) ([]string, error) {
+	files, err := ioutil.ReadDir(filepath.Join(cacheDir, CACHE_CHANNEL_DIRECTORY))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var channels []string
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name, err := base64.RawURLEncoding.DecodeString(f.Name())
+		if err != nil {
+			// Not a head file
+			continue
+		}
+		channels = append(channels, string(name))
+	}
+	sort.Strings(channels)
+	return channels, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"errors"
+	"fyne.io/fyne/v2"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	PREFERENCE_KEY_HOSTS = "key_hosts"
+)
+
+var ErrInsecureHost = errors.New("Host must use https unless it is on this device")
+
+// KeyPorter exports and imports keys through a host, as bcclientgo.BCClient does.
+type KeyPorter interface {
+	ExportKeys(host, alias string, password []byte) (string, error)
+	ImportKeys(host, alias, accessCode string) error
+}
+
+// ExportKeys exports the keys of the alias to the host with the porter, remembering the host once the export succeeds, and returns the access code.
+func ExportKeys(p fyne.Preferences, porter KeyPorter, host, alias string, password []byte) (string, error) {
+	access, err := porter.ExportKeys(host, alias, password)
+	if err != nil {
+		return "", err
+	}
+	AddKeyHost(p, host)
+	return access, nil
+}
+
+// ImportKeys imports the keys of the alias from the host with the porter, remembering the host once the import succeeds.
+func ImportKeys(p fyne.Preferences, porter KeyPorter, host, alias, accessCode string) error {
+	if err := porter.ImportKeys(host, alias, accessCode); err != nil {
+		return err
+	}
+	AddKeyHost(p, host)
+	return nil
+}
+
+// ParseKeyHost returns the URL of the host used to import and export keys, without a trailing slash.
+// Plain http is only accepted for loopback addresses, such as a local test server.
+func ParseKeyHost(host string) (string, error) {
+	host = strings.TrimSpace(host)
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+	if u.Hostname() == "" {
+		return "", errors.New("Missing host name")
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if u.Hostname() != "localhost" {
+			if ip := net.ParseIP(u.Hostname()); ip == nil || !ip.IsLoopback() {
+				return "", ErrInsecureHost
+			}
+		}
+	default:
+		return "", errors.New("Unsupported scheme: " + u.Scheme)
+	}
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
+
+// KeyHosts returns the hosts used to import and export keys, the default first followed by the custom hosts most recently used.
+func KeyHosts(p fyne.Preferences) []string {
+	hosts := []string{bcgo.BCWebsite()}
+	for _, h := range bcgo.SplitRemoveEmpty(p.String(PREFERENCE_KEY_HOSTS), ",") {
+		if h != hosts[0] {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// AddKeyHost remembers the host if it is not the default.
+func AddKeyHost(p fyne.Preferences, host string) {
+	if host == bcgo.BCWebsite() {
+		return
+	}
+	hosts := []string{host}
+	for _, h := range KeyHosts(p)[1:] {
+		if h != host {
+			hosts = append(hosts, h)
+		}
+	}
+	p.SetString(PREFERENCE_KEY_HOSTS, strings.Join(hosts, ","))
+}
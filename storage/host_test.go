@@ -0,0 +1,188 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/cryptogo"
+	"fyne.io/fyne/v2/test"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// makeClient returns a client rooted in a new directory, with a key for Alice in its keystore.
+func makeClient(t *testing.T, password []byte) (bcclientgo.BCClient, string) {
+	t.Helper()
+	root, err := ioutil.TempDir("", "bcfyne")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystore, err := bcgo.KeyDirectory(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cryptogo.WriteRSAPrivateKey(makeKey(t), keystore, "Alice", password); err != nil {
+		t.Fatal(err)
+	}
+	client := bcclientgo.NewBCClient()
+	client.SetRoot(root)
+	return client, root
+}
+
+func Test_ParseKeyHost(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	for name, tt := range map[string]struct {
+		host, want string
+		err        bool
+	}{
+		"name":     {host: "bc.example.com", want: "https://bc.example.com"},
+		"slash":    {host: " https://bc.example.com/ ", want: "https://bc.example.com"},
+		"port":     {host: "https://bc.example.com:8443", want: "https://bc.example.com:8443"},
+		"http":     {host: "http://bc.example.com", err: true},
+		"scheme":   {host: "ftp://bc.example.com", err: true},
+		"empty":    {host: "", err: true},
+		"loopback": {host: server.URL, want: server.URL},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := storage.ParseKeyHost(tt.host)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("Expected error, got '%s'", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("Incorrect host; expected '%s', got '%s'", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_KeyHosts(t *testing.T) {
+	p := test.NewApp().Preferences()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	storage.AddKeyHost(p, "https://a.example.com")
+	storage.AddKeyHost(p, server.URL)
+	storage.AddKeyHost(p, bcgo.BCWebsite())
+	storage.AddKeyHost(p, "https://a.example.com")
+	want := []string{bcgo.BCWebsite(), "https://a.example.com", server.URL}
+	if got := storage.KeyHosts(p); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Incorrect hosts; expected %v, got %v", want, got)
+	}
+}
+
+func Test_ExportKeys(t *testing.T) {
+	password := []byte("password1234")
+	t.Run("Exported", func(t *testing.T) {
+		client, root := makeClient(t, password)
+		defer os.RemoveAll(root)
+		var posted bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/keys" {
+				t.Errorf("Incorrect request; expected 'POST /keys', got '%s %s'", r.Method, r.URL.Path)
+				http.NotFound(w, r)
+				return
+			}
+			if err := r.ParseForm(); err != nil {
+				t.Error(err)
+			}
+			if got := r.PostForm.Get("alias"); got != "Alice" {
+				t.Errorf("Incorrect alias; expected 'Alice', got '%s'", got)
+			}
+			for key, values := range r.PostForm {
+				for _, v := range values {
+					if strings.Contains(v, string(password)) {
+						t.Errorf("Expected password to not be sent in the clear, got it in '%s'", key)
+					}
+				}
+			}
+			posted = true
+		}))
+		defer server.Close()
+		p := test.NewApp().Preferences()
+		access, err := storage.ExportKeys(p, client, server.URL, "Alice", password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !posted {
+			t.Fatal("Expected keys to be posted to host")
+		}
+		if access == "" {
+			t.Fatal("Expected access code")
+		}
+		want := []string{bcgo.BCWebsite(), server.URL}
+		if got := storage.KeyHosts(p); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect hosts; expected %v, got %v", want, got)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		client, root := makeClient(t, password)
+		defer os.RemoveAll(root)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Unavailable", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		p := test.NewApp().Preferences()
+		if _, err := storage.ExportKeys(p, client, server.URL, "Alice", password); err == nil {
+			t.Fatal("Expected error")
+		}
+		want := []string{bcgo.BCWebsite()}
+		if got := storage.KeyHosts(p); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect hosts; expected %v, got %v", want, got)
+		}
+	})
+}
+
+func Test_ImportKeys(t *testing.T) {
+	client, root := makeClient(t, []byte("password1234"))
+	defer os.RemoveAll(root)
+	var requested bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/keys" {
+			t.Errorf("Incorrect request; expected 'GET /keys', got '%s %s'", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("alias"); got != "Bob" {
+			t.Errorf("Incorrect alias; expected 'Bob', got '%s'", got)
+		}
+		requested = true
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+	p := test.NewApp().Preferences()
+	if err := storage.ImportKeys(p, client, server.URL, "Bob", "access"); err == nil {
+		t.Fatal("Expected error")
+	}
+	if !requested {
+		t.Fatal("Expected keys to be requested from host")
+	}
+	want := []string{bcgo.BCWebsite()}
+	if got := storage.KeyHosts(p); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Incorrect hosts; expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const INBOX_FILE_FORMAT = "inbox-%s.json"
+
+// InboxItem identifies a record which grants access to the owner of the inbox.
+type InboxItem struct {
+	Channel    string `json:"channel"`
+	BlockHash  []byte `json:"block"`
+	RecordHash []byte `json:"record"`
+	Creator    string `json:"creator"`
+	Timestamp  uint64 `json:"timestamp"`
+	Read       bool   `json:"read,omitempty"`
+}
+
+func (i *InboxItem) URI() RecordURI {
+	return NewRecordURI(i.Channel, i.BlockHash, i.RecordHash)
+}
+
+// Inbox is a locally maintained list of the records shared with an alias.
+type Inbox interface {
+	// Alias returns the alias the records are shared with.
+	Alias() string
+	// Channels returns the scanned channels mapped to their last scanned head.
+	Channels() map[string][]byte
+	// Items returns the records shared with the alias, newest first.
+	Items() []*InboxItem
+	// Unread returns the number of unread items.
+	Unread() int
+	// SetRead marks the item with the given record hash as read or unread.
+	SetRead(recordHash []byte, read bool) error
+	// Update scans the blocks of the given channel from head back to the last scanned block.
+	Update(channel string, head []byte, cache bcgo.Cache, network bcgo.Network) error
+}
+
+type inbox struct {
+	sync.Mutex
+	file    string
+	alias   string
+	Heads   map[string][]byte `json:"heads"`
+	Entries []*InboxItem      `json:"items"`
+}
+
+// NewInbox returns an Inbox of the given alias persisted in the given directory.
+func NewInbox(directory, alias string) (Inbox, error) {
+	i := &inbox{
+		file:  filepath.Join(directory, fmt.Sprintf(INBOX_FILE_FORMAT, alias)),
+		alias: alias,
+		Heads: make(map[string][]byte),
+	}
+	if err := readJSON(i.file, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *inbox) Alias() string {
+	return i.alias
+}
+
+func (i *inbox) Channels() map[string][]byte {
+	i.Lock()
+	defer i.Unlock()
+	channels := make(map[string][]byte, len(i.Heads))
+	for k, v := range i.Heads {
+		channels[k] = v
+	}
+	return channels
+}
+
+func (i *inbox) Items() []*InboxItem {
+	i.Lock()
+	defer i.Unlock()
+	items := make([]*InboxItem, len(i.Entries))
+	for j, e := range i.Entries {
+		item := *e
+		items[j] = &item
+	}
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].Timestamp > items[b].Timestamp
+	})
+	return items
+}
+
+func (i *inbox) Unread() int {
+	i.Lock()
+	defer i.Unlock()
+	unread := 0
+	for _, e := range i.Entries {
+		if !e.Read {
+			unread++
+		}
+	}
+	return unread
+}
+
+func (i *inbox) SetRead(recordHash []byte, read bool) error {
+	i.Lock()
+	defer i.Unlock()
+	for _, e := range i.Entries {
+		if bytes.Equal(e.RecordHash, recordHash) {
+			if e.Read == read {
+				return nil
+			}
+			e.Read = read
+			return i.save()
+		}
+	}
+	return fmt.Errorf("Record not in inbox")
+}
+
+func (i *inbox) Update(channel string, head []byte, cache bcgo.Cache, network bcgo.Network) error {
+	if len(head) == 0 {
+		return nil
+	}
+	i.Lock()
+	defer i.Unlock()
+	last := i.Heads[channel]
+	if bytes.Equal(head, last) {
+		return nil
+	}
+	if err := iterateEntries(channel, head, last, cache, network, func(hash []byte, entry *bcgo.BlockEntry) {
+		for _, a := range entry.Record.Access {
+			if a.Alias == i.alias {
+				i.add(&InboxItem{
+					Channel:    channel,
+					BlockHash:  hash,
+					RecordHash: entry.RecordHash,
+					Creator:    entry.Record.Creator,
+					Timestamp:  entry.Record.Timestamp,
+				})
+				break
+			}
+		}
+	}); err != nil {
+		return err
+	}
+	i.Heads[channel] = head
+	return i.save()
+}
+
+func (i *inbox) add(item *InboxItem) {
+	for _, e := range i.Entries {
+		if e.Channel == item.Channel && bytes.Equal(e.RecordHash, item.RecordHash) {
+			return
+		}
+	}
+	i.Entries = append(i.Entries, item)
+}
+
+func (i *inbox) save() error {
+	return writeJSON(i.file, i)
+}
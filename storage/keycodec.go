@@ -0,0 +1,88 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/account"
+	"aletheiaware.com/cryptogo"
+	"fmt"
+)
+
+// KeyCodec reads, re-encrypts and parses the keys of one algorithm, so the keystore works with keys of any algorithm.
+type KeyCodec interface {
+	// Load reads the key of the alias in the directory, decrypts it with the password, and returns an account holding it.
+	Load(directory, alias string, password []byte) (bcgo.Account, error)
+	// Reencrypt reads the key of the alias in the source directory with the password, and writes it to the destination directory encrypted with the new password.
+	Reencrypt(source, destination, alias string, password, newPassword []byte) error
+	// Identity parses the public key in the given format, and returns an identity of the alias holding it.
+	Identity(alias string, key []byte, format cryptogo.PublicKeyFormat) (bcgo.Identity, error)
+}
+
+// ErrUnsupportedKey is returned when no codec can parse a public key.
+type ErrUnsupportedKey struct {
+	Alias  string
+	Format cryptogo.PublicKeyFormat
+}
+
+func (e ErrUnsupportedKey) Error() string {
+	return fmt.Sprintf("Unsupported key of %s in format %s", e.Alias, e.Format)
+}
+
+// ParseIdentity returns an identity of the alias holding the public key, parsed by the first of the codecs which supports it.
+func ParseIdentity(codecs []KeyCodec, alias string, key []byte, format cryptogo.PublicKeyFormat) (bcgo.Identity, error) {
+	for _, c := range codecs {
+		if identity, err := c.Identity(alias, key, format); err == nil {
+			return identity, nil
+		}
+	}
+	return nil, ErrUnsupportedKey{
+		Alias:  alias,
+		Format: format,
+	}
+}
+
+type rsaKeyCodec struct{}
+
+// NewRSAKeyCodec returns a KeyCodec of RSA keys.
+func NewRSAKeyCodec() KeyCodec {
+	return &rsaKeyCodec{}
+}
+
+func (r *rsaKeyCodec) Load(directory, alias string, password []byte) (bcgo.Account, error) {
+	key, err := cryptogo.RSAPrivateKey(directory, alias, password)
+	if err != nil {
+		return nil, err
+	}
+	return account.NewRSA(alias, key), nil
+}
+
+func (r *rsaKeyCodec) Reencrypt(source, destination, alias string, password, newPassword []byte) error {
+	key, err := cryptogo.RSAPrivateKey(source, alias, password)
+	if err != nil {
+		return err
+	}
+	return cryptogo.WriteRSAPrivateKey(key, destination, alias, newPassword)
+}
+
+func (r *rsaKeyCodec) Identity(alias string, key []byte, format cryptogo.PublicKeyFormat) (bcgo.Identity, error) {
+	k, err := cryptogo.ParseRSAPublicKey(key, format)
+	if err != nil {
+		return nil, err
+	}
+	return account.NewRSAIdentity(alias, k), nil
+}
@@ -0,0 +1,443 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/cryptogo"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/pbkdf2"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// KEY_ARCHIVE_DIRECTORY holds the keys replaced by rotation, one directory per alias.
+const KEY_ARCHIVE_DIRECTORY = "archive"
+
+// StagedKey is a key written to a temporary file in the keystore, ready to replace the current key of an alias.
+type StagedKey struct {
+	temp string
+	name string
+}
+
+// StageKey calls write to write a key to a temporary directory in the keystore, and checks it can be read back with the password.
+func StageKey(codec KeyCodec, keystore, alias string, password []byte, write func(directory string) error) (*StagedKey, error) {
+	// Temporary directory is inside the keystore so the rename stays on the same file system
+	temp, err := ioutil.TempDir(keystore, "."+alias+"-")
+	if err != nil {
+		return nil, err
+	}
+	k := &StagedKey{
+		temp: temp,
+	}
+	if err := write(temp); err != nil {
+		k.Discard()
+		return nil, err
+	}
+	// Ensure the new file can be read back before it replaces anything
+	if _, err := codec.Load(temp, alias, password); err != nil {
+		k.Discard()
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(temp)
+	if err != nil {
+		k.Discard()
+		return nil, err
+	}
+	if len(files) != 1 {
+		k.Discard()
+		return nil, fmt.Errorf("Expected one key file, found %d", len(files))
+	}
+	k.name = files[0].Name()
+	return k, nil
+}
+
+// Commit renames the staged key over the current key in the keystore.
+// The staged key is kept if the rename fails, so it can be recovered by hand.
+func (k *StagedKey) Commit(keystore string) error {
+	original := filepath.Join(keystore, k.name)
+	if _, err := os.Stat(original); err != nil {
+		return fmt.Errorf("Key file not found: %s", err)
+	}
+	if err := os.Rename(filepath.Join(k.temp, k.name), original); err != nil {
+		return fmt.Errorf("Could not replace key with %s: %s", k.temp, err)
+	}
+	return k.Discard()
+}
+
+// Add renames the staged key into the keystore as the key of an alias which has none, and returns the path of the key file.
+func (k *StagedKey) Add(keystore string) (string, error) {
+	path := filepath.Join(keystore, k.name)
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("Key file already exists: %s", path)
+	}
+	if err := os.Rename(filepath.Join(k.temp, k.name), path); err != nil {
+		return "", err
+	}
+	return path, k.Discard()
+}
+
+// Path returns the file holding the staged key, so it can be recovered by hand if it cannot be committed.
+func (k *StagedKey) Path() string {
+	return filepath.Join(k.temp, k.name)
+}
+
+// Discard removes the staged key.
+func (k *StagedKey) Discard() error {
+	return os.RemoveAll(k.temp)
+}
+
+// ChangePassword re-encrypts the key of the given alias in the keystore, and the keys archived by rotation, with a new password.
+// Every key is written to a temporary location in the keystore before any is renamed over the original,
+// and the original archive is restored if the key cannot be replaced, so the keys are never left encrypted with a mix of passwords.
+func ChangePassword(codec KeyCodec, keystore, alias string, password, newPassword []byte) error {
+	if len(newPassword) < cryptogo.MIN_PASSWORD {
+		return cryptogo.ErrPasswordTooShort{Size: len(newPassword), Min: cryptogo.MIN_PASSWORD}
+	}
+	// Authenticate with the current password
+	if _, err := codec.Load(keystore, alias, password); err != nil {
+		return err
+	}
+	staged, err := StageKey(codec, keystore, alias, newPassword, func(directory string) error {
+		return codec.Reencrypt(keystore, directory, alias, password, newPassword)
+	})
+	if err != nil {
+		return err
+	}
+	archive, err := stageArchive(codec, keystore, alias, password, newPassword)
+	if err != nil {
+		staged.Discard()
+		return err
+	}
+	if err := archive.commit(); err != nil {
+		staged.Discard()
+		archive.discard()
+		return err
+	}
+	if err := staged.Commit(keystore); err != nil {
+		staged.Discard()
+		if err := archive.rollback(); err != nil {
+			log.Println(err)
+		}
+		return err
+	}
+	return archive.discard()
+}
+
+// ArchiveKey keeps a copy of the key of the given alias, encrypted with the password, so records shared with it can still be decrypted after rotation.
+// The returned directory holds the archived key.
+func ArchiveKey(codec KeyCodec, keystore, alias string, password []byte) (string, error) {
+	directory := filepath.Join(keystore, KEY_ARCHIVE_DIRECTORY, alias, strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err := os.MkdirAll(directory, os.ModePerm); err != nil {
+		return "", err
+	}
+	if err := codec.Reencrypt(keystore, directory, alias, password, password); err != nil {
+		os.RemoveAll(directory)
+		return "", err
+	}
+	return directory, nil
+}
+
+// ArchivedKeys returns accounts holding the archived keys of the given alias, newest first.
+// An error is returned if any archived key cannot be decrypted with the password.
+func ArchivedKeys(codec KeyCodec, keystore, alias string, password []byte) ([]bcgo.Account, error) {
+	directory := filepath.Join(keystore, KEY_ARCHIVE_DIRECTORY, alias)
+	names, err := archivedKeys(directory)
+	if err != nil {
+		return nil, err
+	}
+	var accounts []bcgo.Account
+	for _, n := range names {
+		account, err := codec.Load(filepath.Join(directory, n), alias, password)
+		if err != nil {
+			return nil, fmt.Errorf("Could not read archived key %s of %s: %s", n, alias, err)
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// archivedKeys returns the names of the directories in the archive each holding a key, newest first.
+func archivedKeys(directory string) ([]string, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, f := range files {
+		if f.IsDir() {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	return names, nil
+}
+
+// stagedArchive is a copy of the archive of an alias re-encrypted with a new password, ready to replace the original.
+type stagedArchive struct {
+	directory string
+	temp      string
+	old       string
+}
+
+// stageArchive writes the archived keys encrypted with the new password to a temporary directory beside the archive of the alias.
+// Nil is returned if there are no archived keys.
+func stageArchive(codec KeyCodec, keystore, alias string, password, newPassword []byte) (*stagedArchive, error) {
+	parent := filepath.Join(keystore, KEY_ARCHIVE_DIRECTORY)
+	directory := filepath.Join(parent, alias)
+	names, err := archivedKeys(directory)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	temp, err := ioutil.TempDir(parent, "."+alias+"-")
+	if err != nil {
+		return nil, err
+	}
+	a := &stagedArchive{
+		directory: directory,
+		temp:      temp,
+	}
+	for _, n := range names {
+		if err := codec.Reencrypt(filepath.Join(directory, n), filepath.Join(temp, n), alias, password, newPassword); err != nil {
+			a.discard()
+			return nil, fmt.Errorf("Could not re-encrypt archived key %s of %s: %s", n, alias, err)
+		}
+	}
+	return a, nil
+}
+
+// commit moves the original archive aside and renames the staged archive in its place.
+func (a *stagedArchive) commit() error {
+	if a == nil {
+		return nil
+	}
+	a.old = a.temp + "-old"
+	if err := os.Rename(a.directory, a.old); err != nil {
+		a.old = ""
+		return err
+	}
+	if err := os.Rename(a.temp, a.directory); err != nil {
+		if err := os.Rename(a.old, a.directory); err != nil {
+			log.Println(err)
+		}
+		a.old = ""
+		return err
+	}
+	a.temp = ""
+	return nil
+}
+
+// rollback restores the original archive after a commit.
+func (a *stagedArchive) rollback() error {
+	if a == nil || a.old == "" {
+		return nil
+	}
+	if err := os.RemoveAll(a.directory); err != nil {
+		return err
+	}
+	if err := os.Rename(a.old, a.directory); err != nil {
+		return fmt.Errorf("Could not restore archive from %s: %s", a.old, err)
+	}
+	a.old = ""
+	return nil
+}
+
+// discard removes whichever of the staged and original archives is no longer needed.
+func (a *stagedArchive) discard() error {
+	if a == nil {
+		return nil
+	}
+	if a.temp != "" {
+		if err := os.RemoveAll(a.temp); err != nil {
+			return err
+		}
+	}
+	if a.old != "" {
+		if err := os.RemoveAll(a.old); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	// KEY_FILE_TYPE is the PEM block type of a key file encrypted by EncodeKeyFile.
+	KEY_FILE_TYPE = "BCFYNE ENCRYPTED PRIVATE KEY"
+	// KEY_FILE_KDF names the function deriving the encryption key of a key file from its password.
+	KEY_FILE_KDF = "PBKDF2-HMAC-SHA256"
+	// KEY_FILE_ITERATIONS is the number of iterations used to derive the encryption key of a key file.
+	KEY_FILE_ITERATIONS = 200000
+	// KEY_FILE_MAX_ITERATIONS is the most iterations accepted when opening a key file, so a crafted file cannot stall key derivation.
+	KEY_FILE_MAX_ITERATIONS = 10 * KEY_FILE_ITERATIONS
+	// KEY_FILE_SALT_SIZE is the number of bytes of random salt used to derive the encryption key of a key file.
+	KEY_FILE_SALT_SIZE = 16
+)
+
+var (
+	ErrNotPrivateKey       = errors.New("File does not hold a private key")
+	ErrWrongKeyType        = errors.New("File holds a different type of private key")
+	ErrUnsupportedKeyFile  = errors.New("Key file is encrypted with an unsupported key derivation function")
+	ErrKeyFileIterations   = errors.New("Key file has an invalid number of iterations")
+	ErrKeyFileSaltTooShort = errors.New("Key file salt is too short")
+)
+
+// EncodeKeyFile encrypts the private key with the password as a PEM encoded key file, such as for use outside the keystore.
+// The key is marshalled as PKCS8 and sealed with AES-256-GCM under a key derived from the password with PBKDF2, the salt and iterations are held in the PEM headers.
+func EncodeKeyFile(key crypto.PrivateKey, password []byte) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, KEY_FILE_SALT_SIZE)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := keyFileCipher(password, salt, KEY_FILE_ITERATIONS)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type: KEY_FILE_TYPE,
+		Headers: map[string]string{
+			"KDF":        KEY_FILE_KDF,
+			"Iterations": strconv.Itoa(KEY_FILE_ITERATIONS),
+			"Salt":       hex.EncodeToString(salt),
+		},
+		Bytes: aead.Seal(nonce, nonce, der, nil),
+	}), nil
+}
+
+// ParseKeyFile decrypts the PEM encoded private key with the password, such as a key file from outside the keystore.
+// Key files written by EncodeKeyFile, legacy encrypted PEM blocks, and unencrypted PKCS1, EC and PKCS8 keys are all accepted.
+// The key is returned as its crypto type, such as *rsa.PrivateKey, *ecdsa.PrivateKey or ed25519.PrivateKey, for the account factory of its algorithm.
+func ParseKeyFile(data, password []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrNotPrivateKey
+	}
+	if block.Type == KEY_FILE_TYPE {
+		der, err := openKeyFile(block, password)
+		if err != nil {
+			return nil, err
+		}
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, ErrNotPrivateKey
+		}
+		return key, nil
+	}
+	der := block.Bytes
+	if x509.IsEncryptedPEMBlock(block) {
+		d, err := x509.DecryptPEMBlock(block, password)
+		if err != nil {
+			return nil, err
+		}
+		der = d
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		if x509.IsEncryptedPEMBlock(block) {
+			// Decrypting with the wrong password only fails some of the time, otherwise it yields garbage
+			return nil, x509.IncorrectPasswordError
+		}
+		return nil, ErrNotPrivateKey
+	}
+	return key, nil
+}
+
+func openKeyFile(block *pem.Block, password []byte) ([]byte, error) {
+	if block.Headers["KDF"] != KEY_FILE_KDF {
+		return nil, ErrUnsupportedKeyFile
+	}
+	iterations, err := strconv.Atoi(block.Headers["Iterations"])
+	if err != nil || iterations < 1 || iterations > KEY_FILE_MAX_ITERATIONS {
+		return nil, ErrKeyFileIterations
+	}
+	salt, err := hex.DecodeString(block.Headers["Salt"])
+	if err != nil {
+		return nil, err
+	}
+	if len(salt) < KEY_FILE_SALT_SIZE {
+		return nil, ErrKeyFileSaltTooShort
+	}
+	aead, err := keyFileCipher(password, salt, iterations)
+	if err != nil {
+		return nil, err
+	}
+	size := aead.NonceSize()
+	if len(block.Bytes) < size {
+		return nil, ErrNotPrivateKey
+	}
+	der, err := aead.Open(nil, block.Bytes[:size], block.Bytes[size:], nil)
+	if err != nil {
+		// The authentication tag only matches when the password is correct
+		return nil, x509.IncorrectPasswordError
+	}
+	return der, nil
+}
+
+func keyFileCipher(password, salt []byte, iterations int) (cipher.AEAD, error) {
+	c, err := aes.NewCipher(pbkdf2.Key(password, salt, iterations, 32, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(c)
+}
+
+// ParseRSAKeyFile decrypts the PEM encoded RSA private key with the password, and returns ErrWrongKeyType if the file holds another type of key.
+func ParseRSAKeyFile(data, password []byte) (*rsa.PrivateKey, error) {
+	k, err := ParseKeyFile(data, password)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrWrongKeyType
+	}
+	return key, nil
+}
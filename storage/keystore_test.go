@@ -0,0 +1,270 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/cryptogo"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func makeKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func Test_ChangePassword(t *testing.T) {
+	password := []byte("password1234")
+	newPassword := []byte("password5678")
+	t.Run("Archive", func(t *testing.T) {
+		keystore := makeDirectory(t)
+		defer os.RemoveAll(keystore)
+		codec := storage.NewRSAKeyCodec()
+		archived := makeKey(t)
+		if err := cryptogo.WriteRSAPrivateKey(archived, keystore, "Alice", password); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := storage.ArchiveKey(codec, keystore, "Alice", password); err != nil {
+			t.Fatal(err)
+		}
+		key := makeKey(t)
+		if err := cryptogo.WriteRSAPrivateKey(key, keystore, "Alice", password); err != nil {
+			t.Fatal(err)
+		}
+		if err := storage.ChangePassword(codec, keystore, "Alice", password, newPassword); err != nil {
+			t.Fatal(err)
+		}
+		got, err := cryptogo.RSAPrivateKey(keystore, "Alice", newPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.D.Cmp(got.D) != 0 {
+			t.Fatal("Incorrect key")
+		}
+		accounts, err := storage.ArchivedKeys(codec, keystore, "Alice", newPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(accounts) != 1 {
+			t.Fatalf("Incorrect archived keys; expected 1, got %d", len(accounts))
+		}
+		_, public, err := accounts[0].PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, err := cryptogo.RSAPublicKeyToPKIXBytes(&archived.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, public) {
+			t.Fatal("Incorrect archived key")
+		}
+		if _, err := storage.ArchivedKeys(codec, keystore, "Alice", password); err == nil {
+			t.Fatal("Expected archived key to no longer open with the old password")
+		}
+	})
+	t.Run("WrongPassword", func(t *testing.T) {
+		keystore := makeDirectory(t)
+		defer os.RemoveAll(keystore)
+		key := makeKey(t)
+		if err := cryptogo.WriteRSAPrivateKey(key, keystore, "Alice", password); err != nil {
+			t.Fatal(err)
+		}
+		if err := storage.ChangePassword(storage.NewRSAKeyCodec(), keystore, "Alice", []byte("wrongpassword"), newPassword); err == nil {
+			t.Fatal("Expected error")
+		}
+		if _, err := cryptogo.RSAPrivateKey(keystore, "Alice", password); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func Test_StagedKey_Add(t *testing.T) {
+	password := []byte("password1234")
+	keystore := makeDirectory(t)
+	defer os.RemoveAll(keystore)
+	codec := storage.NewRSAKeyCodec()
+	// Key of another alias must be left alone
+	if err := cryptogo.WriteRSAPrivateKey(makeKey(t), keystore, "Bob", password); err != nil {
+		t.Fatal(err)
+	}
+	staged, err := storage.StageKey(codec, keystore, "Alice", password, func(directory string) error {
+		return cryptogo.WriteRSAPrivateKey(makeKey(t), directory, "Alice", password)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := staged.Add(keystore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := codec.Load(keystore, "Alice", password); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := codec.Load(keystore, "Alice", password); err == nil {
+		t.Fatal("Expected error")
+	}
+	if _, err := codec.Load(keystore, "Bob", password); err != nil {
+		t.Fatal(err)
+	}
+	files, err := ioutil.ReadDir(keystore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Incorrect files; expected 1, got %d", len(files))
+	}
+}
+
+func Test_ParseKeyFile(t *testing.T) {
+	password := []byte("password1234")
+	key := makeKey(t)
+	t.Run("Encrypted", func(t *testing.T) {
+		data, err := storage.EncodeKeyFile(key, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := storage.ParseRSAKeyFile(data, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.D.Cmp(got.D) != 0 {
+			t.Fatal("Incorrect key")
+		}
+	})
+	t.Run("WrongPassword", func(t *testing.T) {
+		data, err := storage.EncodeKeyFile(key, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := storage.ParseKeyFile(data, []byte("wrongpassword")); err != x509.IncorrectPasswordError {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", x509.IncorrectPasswordError, err)
+		}
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		data, err := storage.EncodeKeyFile(key, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block, _ := pem.Decode(data)
+		block.Bytes[len(block.Bytes)-1] ^= 1
+		if _, err := storage.ParseKeyFile(pem.EncodeToMemory(block), password); err != x509.IncorrectPasswordError {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", x509.IncorrectPasswordError, err)
+		}
+	})
+	t.Run("TooManyIterations", func(t *testing.T) {
+		data, err := storage.EncodeKeyFile(key, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block, _ := pem.Decode(data)
+		block.Headers["Iterations"] = strconv.Itoa(storage.KEY_FILE_MAX_ITERATIONS + 1)
+		if _, err := storage.ParseKeyFile(pem.EncodeToMemory(block), password); err != storage.ErrKeyFileIterations {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrKeyFileIterations, err)
+		}
+	})
+	t.Run("Legacy", func(t *testing.T) {
+		block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), password, x509.PEMCipherAES256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := pem.EncodeToMemory(block)
+		got, err := storage.ParseRSAKeyFile(data, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.D.Cmp(got.D) != 0 {
+			t.Fatal("Incorrect key")
+		}
+		if _, err := storage.ParseKeyFile(data, []byte("wrongpassword")); err != x509.IncorrectPasswordError {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", x509.IncorrectPasswordError, err)
+		}
+	})
+	t.Run("Unencrypted", func(t *testing.T) {
+		pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, block := range map[string]*pem.Block{
+			"PKCS1": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+			"PKCS8": {Type: "PRIVATE KEY", Bytes: pkcs8},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// The password is ignored
+				got, err := storage.ParseRSAKeyFile(pem.EncodeToMemory(block), password)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if key.D.Cmp(got.D) != 0 {
+					t.Fatal("Incorrect key")
+				}
+			})
+		}
+	})
+	t.Run("OtherAlgorithm", func(t *testing.T) {
+		other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := storage.EncodeKeyFile(other, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := storage.ParseKeyFile(data, password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k, ok := got.(*ecdsa.PrivateKey); !ok {
+			t.Fatalf("Incorrect key type; expected *ecdsa.PrivateKey, got %T", got)
+		} else if k.D.Cmp(other.D) != 0 {
+			t.Fatal("Incorrect key")
+		}
+		if _, err := storage.ParseRSAKeyFile(data, password); err != storage.ErrWrongKeyType {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrWrongKeyType, err)
+		}
+	})
+	t.Run("NotKey", func(t *testing.T) {
+		for name, data := range map[string][]byte{
+			"Empty":       nil,
+			"Text":        []byte("Hello World"),
+			"Certificate": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("Hello World")}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				if _, err := storage.ParseKeyFile(data, password); err != storage.ErrNotPrivateKey {
+					t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrNotPrivateKey, err)
+				}
+			})
+		}
+	})
+}
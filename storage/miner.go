@@ -0,0 +1,166 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcgo"
+	"context"
+	"errors"
+	"sync"
+)
+
+// errMiningAbandoned aborts node.Mine from within the listener once the context is done.
+var errMiningAbandoned = errors.New("Mining abandoned")
+
+// Mine mines the entries written to the channel since it was last mined with node.Mine, but returns when the context is done.
+// Mining left running by a done context is stopped by its listener at the next progress update, and always before the block is written,
+// unless the threshold was already reached, in which case Mine waits for the block.
+func Mine(ctx context.Context, node bcgo.Node, channel bcgo.Channel, threshold uint64, listener bcgo.MiningListener) ([]byte, *bcgo.Block, error) {
+	type result struct {
+		hash  []byte
+		block *bcgo.Block
+		err   error
+	}
+	l := &abandoningListener{
+		ctx:      ctx,
+		listener: listener,
+	}
+	results := make(chan *result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if r != errMiningAbandoned {
+					panic(r)
+				}
+				results <- &result{err: ctx.Err()}
+			}
+		}()
+		hash, block, err := node.Mine(channel, threshold, l)
+		results <- &result{hash, block, err}
+	}()
+	select {
+	case r := <-results:
+		return r.hash, r.block, r.err
+	case <-ctx.Done():
+		if l.abandon() {
+			return nil, nil, ctx.Err()
+		}
+		// Threshold was reached before the context was done, so the block is being written
+		r := <-results
+		return r.hash, r.block, r.err
+	}
+}
+
+// abandoningListener forwards mining progress to the listener, and aborts mining once the context is done.
+type abandoningListener struct {
+	sync.Mutex
+	ctx      context.Context
+	listener bcgo.MiningListener
+	reached  bool
+}
+
+// abandon returns true if the threshold has not been reached, so mining will be aborted before the block is written.
+func (l *abandoningListener) abandon() bool {
+	l.Lock()
+	defer l.Unlock()
+	return !l.reached
+}
+
+func (l *abandoningListener) check() {
+	if l.ctx.Err() != nil {
+		panic(errMiningAbandoned)
+	}
+}
+
+func (l *abandoningListener) OnMiningStarted(channel bcgo.Channel, size uint64) {
+	l.check()
+	if l.listener != nil {
+		l.listener.OnMiningStarted(channel, size)
+	}
+}
+
+func (l *abandoningListener) OnNewMaxOnes(channel bcgo.Channel, nonce, ones uint64) {
+	l.check()
+	if l.listener != nil {
+		l.listener.OnNewMaxOnes(channel, nonce, ones)
+	}
+}
+
+func (l *abandoningListener) OnMiningThresholdReached(channel bcgo.Channel, hash []byte, block *bcgo.Block) {
+	func() {
+		l.Lock()
+		defer l.Unlock()
+		l.check()
+		l.reached = true
+	}()
+	if l.listener != nil {
+		l.listener.OnMiningThresholdReached(channel, hash, block)
+	}
+}
+
+// Register mines an alias record of the node's account, like aliasgo.Register, but stops when the context is done.
+// A record left unmined by an earlier attempt is mined instead of writing another.
+func Register(ctx context.Context, node bcgo.Node, listener bcgo.MiningListener) error {
+	account := node.Account()
+	alias := account.Alias()
+	cache := node.Cache()
+	network := node.Network()
+	aliases, err := node.OpenChannel(aliasgo.ALIAS, aliasgo.OpenAliasChannel)
+	if err != nil {
+		return err
+	}
+	if err := aliases.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	if err := aliasgo.UniqueAlias(aliases, cache, network, alias); err != nil {
+		return err
+	}
+	timestamp, err := node.GetLastMinedTimestamp(aliases)
+	if err != nil {
+		return err
+	}
+	entries, err := cache.BlockEntries(aliasgo.ALIAS, timestamp)
+	if err != nil {
+		return err
+	}
+	pending := false
+	for _, e := range entries {
+		if e.Record.Creator == alias {
+			pending = true
+			break
+		}
+	}
+	if !pending {
+		record, _, err := aliasgo.CreateSignedAliasRecord(account)
+		if err != nil {
+			return err
+		}
+		if _, err := bcgo.WriteRecord(aliasgo.ALIAS, cache, record); err != nil {
+			return err
+		}
+	}
+	if _, _, err := Mine(ctx, node, aliases, aliasgo.ALIAS_THRESHOLD, listener); err != nil {
+		return err
+	}
+	if network != nil {
+		if err := aliases.Push(cache, network); err != nil {
+			// Ignored
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/channel"
+	"bytes"
+	"context"
+	"testing"
+)
+
+type thresholdListener struct {
+	hash []byte
+}
+
+func (l *thresholdListener) OnMiningStarted(channel bcgo.Channel, size uint64) {}
+
+func (l *thresholdListener) OnNewMaxOnes(channel bcgo.Channel, nonce, ones uint64) {}
+
+func (l *thresholdListener) OnMiningThresholdReached(channel bcgo.Channel, hash []byte, block *bcgo.Block) {
+	l.hash = hash
+}
+
+func Test_Mine(t *testing.T) {
+	t.Run("Mined", func(t *testing.T) {
+		n := makeNode(t, "Alice")
+		c := channel.New("Test")
+		for _, payload := range []string{"Hello", "World"} {
+			_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), n.Account(), nil, nil, []byte(payload))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := bcgo.WriteRecord("Test", n.Cache(), record); err != nil {
+				t.Fatal(err)
+			}
+			previous := c.Head()
+			listener := &thresholdListener{}
+			hash, block, err := storage.Mine(context.Background(), n, c, bcgo.THRESHOLD_Z, listener)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(block.Previous, previous) {
+				t.Fatal("Incorrect previous block")
+			}
+			if !bytes.Equal(c.Head(), hash) {
+				t.Fatal("Incorrect head")
+			}
+			if !bytes.Equal(listener.hash, hash) {
+				t.Fatal("Incorrect listener hash")
+			}
+		}
+	})
+	t.Run("NothingToMine", func(t *testing.T) {
+		n := makeNode(t, "Alice")
+		if _, _, err := storage.Mine(context.Background(), n, channel.New("Test"), bcgo.THRESHOLD_Z, nil); err == nil {
+			t.Fatal("Expected error")
+		}
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		n := makeNode(t, "Alice")
+		c := channel.New("Test")
+		_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), n.Account(), nil, nil, []byte("Hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := bcgo.WriteRecord("Test", n.Cache(), record); err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		// No hash can reach the threshold, so mining only ends by cancellation
+		if _, _, err := storage.Mine(ctx, n, c, 512, nil); err != context.Canceled {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", context.Canceled, err)
+		}
+		if head := c.Head(); len(head) != 0 {
+			t.Fatalf("Incorrect head; expected none, got '%x'", head)
+		}
+		// The record is left to be mined later
+		entries, err := n.Cache().BlockEntries("Test", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 {
+			t.Fatalf("Incorrect entries; expected 1, got %d", len(entries))
+		}
+	})
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"encoding/json"
+	"fyne.io/fyne/v2"
+	"log"
+)
+
+const PREFERENCE_NOTIFICATION_RULE_PREFIX = "notification_rule_"
+
+// NotificationRule decides which new records on a channel are announced.
+type NotificationRule struct {
+	Enabled bool `json:"enabled"`
+	// SharedWithMe limits notifications to records the signed-in alias can decrypt.
+	SharedWithMe bool `json:"shared_with_me,omitempty"`
+	// Creators limits notifications to records created by these aliases.
+	Creators []string `json:"creators,omitempty"`
+}
+
+// Matches returns true if the record should be announced to the given alias.
+func (r *NotificationRule) Matches(record *bcgo.Record, alias string) bool {
+	if !r.Enabled {
+		return false
+	}
+	if len(r.Creators) > 0 {
+		found := false
+		for _, c := range r.Creators {
+			if c == record.Creator {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.SharedWithMe {
+		for _, a := range record.Access {
+			if a.Alias == alias {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// NotificationRuleFor returns the rule of the given channel, which is enabled for all records by default.
+func NotificationRuleFor(p fyne.Preferences, channel string) *NotificationRule {
+	rule := &NotificationRule{
+		Enabled: true,
+	}
+	if s := p.String(PREFERENCE_NOTIFICATION_RULE_PREFIX + channel); s != "" {
+		if err := json.Unmarshal([]byte(s), rule); err != nil {
+			log.Println(err)
+		}
+	}
+	return rule
+}
+
+// SetNotificationRule sets the rule of the given channel.
+func SetNotificationRule(p fyne.Preferences, channel string, rule *NotificationRule) error {
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return err
+	}
+	p.SetString(PREFERENCE_NOTIFICATION_RULE_PREFIX+channel, string(data))
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const REFERENCE_INDEX_FILE = "references.json"
+
+var errIndexed = errors.New("already indexed")
+
+// Backlink identifies a record which references another record.
+type Backlink struct {
+	Channel    string `json:"channel"`
+	BlockHash  []byte `json:"block"`
+	RecordHash []byte `json:"record"`
+	Creator    string `json:"creator"`
+	Timestamp  uint64 `json:"timestamp"`
+}
+
+func (b *Backlink) URI() RecordURI {
+	return NewRecordURI(b.Channel, b.BlockHash, b.RecordHash)
+}
+
+// Activity holds the blocks mined by, the records created by, and the records shared with an alias, newest first.
+// Mined blocks are held as backlinks without a record hash.
+type Activity struct {
+	Mined   []*Backlink
+	Created []*Backlink
+	Shared  []*Backlink
+}
+
+// ReferenceIndex is a locally maintained reverse index from a record to the records that reference it, and from an alias to its activity.
+type ReferenceIndex interface {
+	// Activity returns the indexed activity of the given alias.
+	Activity(alias string) *Activity
+	// Channels returns the indexed channels mapped to their last indexed head.
+	Channels() map[string][]byte
+	// ReferencedBy returns the records referencing the given record, newest first.
+	ReferencedBy(recordHash []byte) []*Backlink
+	// Update indexes the blocks of the given channel from head back to the last indexed block.
+	Update(channel string, head []byte, cache bcgo.Cache, network bcgo.Network) error
+}
+
+type referenceIndex struct {
+	sync.Mutex
+	file       string
+	Heads      map[string][]byte      `json:"heads"`
+	References map[string][]*Backlink `json:"references"`
+	MinedBy    map[string][]*Backlink `json:"mined"`
+	CreatedBy  map[string][]*Backlink `json:"created"`
+	SharedWith map[string][]*Backlink `json:"shared"`
+}
+
+// NewReferenceIndex returns a ReferenceIndex persisted in the given directory.
+func NewReferenceIndex(directory string) (ReferenceIndex, error) {
+	i := &referenceIndex{
+		file:       filepath.Join(directory, REFERENCE_INDEX_FILE),
+		Heads:      make(map[string][]byte),
+		References: make(map[string][]*Backlink),
+		MinedBy:    make(map[string][]*Backlink),
+		CreatedBy:  make(map[string][]*Backlink),
+		SharedWith: make(map[string][]*Backlink),
+	}
+	if err := readJSON(i.file, i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *referenceIndex) Activity(alias string) *Activity {
+	i.Lock()
+	defer i.Unlock()
+	return &Activity{
+		Mined:   newestFirst(i.MinedBy[alias]),
+		Created: newestFirst(i.CreatedBy[alias]),
+		Shared:  newestFirst(i.SharedWith[alias]),
+	}
+}
+
+func (i *referenceIndex) Channels() map[string][]byte {
+	i.Lock()
+	defer i.Unlock()
+	channels := make(map[string][]byte, len(i.Heads))
+	for k, v := range i.Heads {
+		channels[k] = v
+	}
+	return channels
+}
+
+func (i *referenceIndex) ReferencedBy(recordHash []byte) []*Backlink {
+	i.Lock()
+	defer i.Unlock()
+	return newestFirst(i.References[base64.RawURLEncoding.EncodeToString(recordHash)])
+}
+
+func (i *referenceIndex) Update(channel string, head []byte, cache bcgo.Cache, network bcgo.Network) error {
+	if len(head) == 0 {
+		return nil
+	}
+	i.Lock()
+	last := i.Heads[channel]
+	i.Unlock()
+	if bytes.Equal(head, last) {
+		return nil
+	}
+	// Walk the blocks without holding the lock so lookups aren't blocked while a channel is indexed
+	references := make(map[string][]*Backlink)
+	mined := make(map[string][]*Backlink)
+	created := make(map[string][]*Backlink)
+	shared := make(map[string][]*Backlink)
+	if err := iterateBlocks(channel, head, last, cache, network, func(hash []byte, block *bcgo.Block) {
+		if block.Miner != "" {
+			mined[block.Miner] = append(mined[block.Miner], &Backlink{
+				Channel:   channel,
+				BlockHash: hash,
+				Creator:   block.Miner,
+				Timestamp: block.Timestamp,
+			})
+		}
+		for _, entry := range block.Entry {
+			backlink := &Backlink{
+				Channel:    channel,
+				BlockHash:  hash,
+				RecordHash: entry.RecordHash,
+				Creator:    entry.Record.Creator,
+				Timestamp:  entry.Record.Timestamp,
+			}
+			for _, reference := range entry.Record.Reference {
+				if len(reference.RecordHash) == 0 {
+					continue
+				}
+				key := base64.RawURLEncoding.EncodeToString(reference.RecordHash)
+				references[key] = append(references[key], backlink)
+			}
+			created[entry.Record.Creator] = append(created[entry.Record.Creator], backlink)
+			for _, a := range entry.Record.Access {
+				shared[a.Alias] = append(shared[a.Alias], backlink)
+			}
+		}
+	}); err != nil {
+		return err
+	}
+	i.Lock()
+	defer i.Unlock()
+	for _, m := range []struct {
+		index   map[string][]*Backlink
+		updates map[string][]*Backlink
+	}{
+		{i.References, references},
+		{i.MinedBy, mined},
+		{i.CreatedBy, created},
+		{i.SharedWith, shared},
+	} {
+		for key, backlinks := range m.updates {
+			for _, b := range backlinks {
+				add(m.index, key, b)
+			}
+		}
+	}
+	i.Heads[channel] = head
+	return i.save()
+}
+
+// iterateBlocks calls the callback with each block of the given channel from head back to, but excluding, the last scanned block.
+func iterateBlocks(channel string, head, last []byte, cache bcgo.Cache, network bcgo.Network, callback func([]byte, *bcgo.Block)) error {
+	if err := bcgo.Iterate(channel, head, nil, cache, network, func(hash []byte, block *bcgo.Block) error {
+		if bytes.Equal(hash, last) {
+			return errIndexed
+		}
+		callback(hash, block)
+		return nil
+	}); err != nil && err != errIndexed {
+		return err
+	}
+	return nil
+}
+
+// iterateEntries calls the callback with each entry in the blocks of the given channel from head back to, but excluding, the last scanned block.
+func iterateEntries(channel string, head, last []byte, cache bcgo.Cache, network bcgo.Network, callback func([]byte, *bcgo.BlockEntry)) error {
+	return iterateBlocks(channel, head, last, cache, network, func(hash []byte, block *bcgo.Block) {
+		for _, entry := range block.Entry {
+			callback(hash, entry)
+		}
+	})
+}
+
+// add appends the backlink to those under the key, unless the same block or record is already there.
+func add(index map[string][]*Backlink, key string, backlink *Backlink) {
+	for _, b := range index[key] {
+		if b.Channel == backlink.Channel && bytes.Equal(b.BlockHash, backlink.BlockHash) && bytes.Equal(b.RecordHash, backlink.RecordHash) {
+			return
+		}
+	}
+	index[key] = append(index[key], backlink)
+}
+
+// newestFirst returns a copy of the backlinks sorted by timestamp, newest first.
+func newestFirst(backlinks []*Backlink) []*Backlink {
+	sorted := append([]*Backlink{}, backlinks...)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Timestamp > sorted[b].Timestamp
+	})
+	return sorted
+}
+
+func (i *referenceIndex) save() error {
+	return writeJSON(i.file, i)
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/account"
+	"aletheiaware.com/bcgo/cache"
+	"aletheiaware.com/bcgo/channel"
+	"aletheiaware.com/bcgo/node"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func makeNode(t *testing.T, alias string) bcgo.Node {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return node.New(account.NewRSA(alias, key), cache.NewMemory(10), nil)
+}
+
+func makeDirectory(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "bcfyne")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// writeRecord writes a record referencing the given records to the channel, then mines it.
+func writeRecord(t *testing.T, n bcgo.Node, c bcgo.Channel, payload string, references ...*bcgo.Reference) *bcgo.Reference {
+	t.Helper()
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), n.Account(), nil, references, []byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference, err := bcgo.WriteRecord(c.Name(), n.Cache(), record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, _, err := n.Mine(c, bcgo.THRESHOLD_Z, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reference.BlockHash = hash
+	return reference
+}
+
+func Test_ReferenceIndex(t *testing.T) {
+	dir := makeDirectory(t)
+	defer os.RemoveAll(dir)
+	n := makeNode(t, "Alice")
+	a := channel.New("A")
+	b := channel.New("B")
+	target := writeRecord(t, n, a, "target")
+	other := writeRecord(t, n, b, "other", target)
+
+	index, err := storage.NewReferenceIndex(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []bcgo.Channel{a, b} {
+		if err := index.Update(c.Name(), c.Head(), n.Cache(), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := index.ReferencedBy(target.RecordHash)
+	if len(got) != 1 {
+		t.Fatalf("Incorrect backlinks; expected 1, got %d", len(got))
+	}
+	if got[0].Channel != "B" || !bytes.Equal(got[0].RecordHash, other.RecordHash) || !bytes.Equal(got[0].BlockHash, other.BlockHash) || got[0].Creator != "Alice" {
+		t.Fatalf("Incorrect backlink; got %+v", got[0])
+	}
+
+	t.Run("Incremental", func(t *testing.T) {
+		same := writeRecord(t, n, a, "same", target)
+		for _, c := range []bcgo.Channel{a, b} {
+			if err := index.Update(c.Name(), c.Head(), n.Cache(), nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		got := index.ReferencedBy(target.RecordHash)
+		if len(got) != 2 {
+			t.Fatalf("Incorrect backlinks; expected 2, got %d", len(got))
+		}
+		// Newest first
+		if !bytes.Equal(got[0].RecordHash, same.RecordHash) || !bytes.Equal(got[1].RecordHash, other.RecordHash) {
+			t.Fatalf("Incorrect backlinks; got %+v %+v", got[0], got[1])
+		}
+		want := map[string][]byte{
+			"A": a.Head(),
+			"B": b.Head(),
+		}
+		if got := index.Channels(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect channels; expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Activity", func(t *testing.T) {
+		_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), n.Account(), []bcgo.Identity{n.Account()}, nil, []byte("shared"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := bcgo.WriteRecord(b.Name(), n.Cache(), record); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := n.Mine(b, bcgo.THRESHOLD_Z, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := index.Update(b.Name(), b.Head(), n.Cache(), nil); err != nil {
+			t.Fatal(err)
+		}
+		activity := index.Activity("Alice")
+		if len(activity.Mined) != 4 {
+			t.Fatalf("Incorrect mined; expected 4, got %d", len(activity.Mined))
+		}
+		if !bytes.Equal(activity.Mined[0].BlockHash, b.Head()) {
+			t.Fatalf("Incorrect mined; expected %s, got %s", base64.RawURLEncoding.EncodeToString(b.Head()), base64.RawURLEncoding.EncodeToString(activity.Mined[0].BlockHash))
+		}
+		if len(activity.Created) != 4 {
+			t.Fatalf("Incorrect created; expected 4, got %d", len(activity.Created))
+		}
+		if len(activity.Shared) != 1 || activity.Shared[0].Channel != "B" {
+			t.Fatalf("Incorrect shared; got %+v", activity.Shared)
+		}
+		if got := index.Activity("Bob"); len(got.Mined) != 0 || len(got.Created) != 0 || len(got.Shared) != 0 {
+			t.Fatalf("Incorrect activity; expected none, got %+v", got)
+		}
+	})
+
+	t.Run("Persisted", func(t *testing.T) {
+		loaded, err := storage.NewReferenceIndex(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, got := index.Channels(), loaded.Channels(); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect channels; expected %v, got %v", want, got)
+		}
+		if want, got := index.ReferencedBy(target.RecordHash), loaded.ReferencedBy(target.RecordHash); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect backlinks; expected %v, got %v", want, got)
+		}
+		if want, got := index.Activity("Alice"), loaded.Activity("Alice"); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Incorrect activity; expected %v, got %v", want, got)
+		}
+	})
+}
+
+func Test_CachedChannels(t *testing.T) {
+	dir := makeDirectory(t)
+	defer os.RemoveAll(dir)
+	channels := filepath.Join(dir, storage.CACHE_CHANNEL_DIRECTORY)
+	if err := os.MkdirAll(filepath.Join(channels, "directory"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{
+		base64.RawURLEncoding.EncodeToString([]byte("Test-B")),
+		base64.RawURLEncoding.EncodeToString([]byte("Alias")),
+		"not+base64",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(channels, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := storage.CachedChannels(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Alias", "Test-B"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Incorrect channels; expected %v, got %v", want, got)
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		empty := makeDirectory(t)
+		defer os.RemoveAll(empty)
+		got, err := storage.CachedChannels(empty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Fatalf("Expected no channels, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"strings"
+)
+
+const PREFERENCE_PENDING_REGISTRATIONS = "pending_registrations"
+
+// ErrAliasTaken is returned when an alias is registered with a key other than the one held.
+type ErrAliasTaken struct {
+	Alias string
+}
+
+func (e ErrAliasTaken) Error() string {
+	return fmt.Sprintf("Alias %s is registered with a different key", e.Alias)
+}
+
+// PendingRegistrations returns the aliases whose key was created but whose registration has not been mined.
+func PendingRegistrations(p fyne.Preferences) []string {
+	return bcgo.SplitRemoveEmpty(p.String(PREFERENCE_PENDING_REGISTRATIONS), ",")
+}
+
+// IsRegistrationPending returns true if the alias has a key awaiting registration.
+func IsRegistrationPending(p fyne.Preferences, alias string) bool {
+	for _, a := range PendingRegistrations(p) {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRegistrationPending marks or unmarks the alias as having a key awaiting registration.
+func SetRegistrationPending(p fyne.Preferences, alias string, pending bool) {
+	var aliases []string
+	for _, a := range PendingRegistrations(p) {
+		if a != alias {
+			aliases = append(aliases, a)
+		}
+	}
+	if pending {
+		aliases = append(aliases, alias)
+	}
+	p.SetString(PREFERENCE_PENDING_REGISTRATIONS, strings.Join(aliases, ","))
+}
+
+// ErrKeyRotated is returned when a key was registered to an alias but has since been replaced.
+type ErrKeyRotated struct {
+	Alias string
+}
+
+func (e ErrKeyRotated) Error() string {
+	return fmt.Sprintf("Key of %s has been rotated", e.Alias)
+}
+
+// VerifyKey returns an error unless the key is the current key of the alias.
+func VerifyKey(codecs []KeyCodec, cache bcgo.Cache, network bcgo.Network, alias string, key []byte) error {
+	history, err := KeyHistory(codecs, cache, network, alias)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(history[len(history)-1].PublicKey, key) {
+		return nil
+	}
+	for _, v := range history {
+		if bytes.Equal(v.PublicKey, key) {
+			return ErrKeyRotated{
+				Alias: alias,
+			}
+		}
+	}
+	return ErrAliasTaken{
+		Alias: alias,
+	}
+}
+
+// IsRegistered returns true if the alias is registered with the given key, or has rotated to it.
+// An error is returned if the alias is registered with a different key.
+func IsRegistered(codecs []KeyCodec, cache bcgo.Cache, network bcgo.Network, alias string, key []byte) (bool, error) {
+	aliases := aliasgo.OpenAliasChannel()
+	if err := aliases.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	if err := aliasgo.UniqueAlias(aliases, cache, network, alias); err == nil {
+		// Alias is not yet registered
+		return false, nil
+	}
+	history, err := KeyHistory(codecs, cache, network, alias)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range history {
+		if bytes.Equal(v.PublicKey, key) {
+			return true, nil
+		}
+	}
+	return false, ErrAliasTaken{
+		Alias: alias,
+	}
+}
@@ -76,8 +76,19 @@ func (r *bcRepository) Copy(fyne.URI, fyne.URI) error {
 }
 
 func (r *bcRepository) CreateListable(u fyne.URI) error {
-	// TODO
-	return fmt.Errorf("%s: Not Yet Implemented", "BCRepository.CreateListable")
+	if _, ok := u.(BlockURI); ok {
+		return ErrInvalidURI
+	}
+	c, ok := u.(ChannelURI)
+	if !ok {
+		return ErrInvalidURI
+	}
+	node, err := r.client.Node()
+	if err != nil {
+		return err
+	}
+	_, err = CreateChannel(node, c.Channel(), DefaultChannelOptions(), nil)
+	return err
 }
 
 func (r *bcRepository) Delete(u fyne.URI) error {
@@ -0,0 +1,237 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/channel"
+	"aletheiaware.com/bcgo/validation"
+	"aletheiaware.com/cryptogo"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+const (
+	// ALIAS_ROTATION is the channel announcing the keys which replace those registered on the alias channel.
+	// Rotations are not published on the alias channel itself, as its validator rejects any block holding a
+	// second record for an alias, so a rotation there would be refused by every peer. This channel is mined to
+	// the same threshold, and each rotation is only followed if it is signed by the key it replaces.
+	ALIAS_ROTATION = "Alias-Rotation"
+
+	META_PUBLIC_FORMAT      = "public_format"
+	META_PREVIOUS_KEY       = "previous_key"
+	META_PREVIOUS_SIGNATURE = "previous_signature"
+	META_PREVIOUS_ALGORITHM = "previous_signature_algorithm"
+)
+
+// KeyVersion is a public key used by an alias from the given time.
+type KeyVersion struct {
+	PublicKey    []byte
+	PublicFormat cryptogo.PublicKeyFormat
+	Timestamp    uint64
+	// URI of the registration or rotation record announcing the key
+	URI RecordURI
+}
+
+// OpenRotationChannel returns the alias rotation channel, validated like the alias channel.
+func OpenRotationChannel() bcgo.Channel {
+	c := channel.New(ALIAS_ROTATION)
+	c.AddValidator(validation.NewPoW(aliasgo.ALIAS_THRESHOLD))
+	return c
+}
+
+// rotationData returns the data signed by the previous key to authorize the new key of the alias.
+func rotationData(alias string, key []byte) []byte {
+	return cryptogo.Hash(append([]byte(alias), key...))
+}
+
+// CreateRotationRecord returns a record created by the new account announcing its key, and signed by the previous account to authorize the rotation.
+func CreateRotationRecord(previous, next bcgo.Account) (*bcgo.Record, error) {
+	alias := previous.Alias()
+	if next.Alias() != alias {
+		return nil, fmt.Errorf("Rotation cannot change alias from %s to %s", alias, next.Alias())
+	}
+	_, previousKey, err := previous.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	format, key, err := next.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	signature, algorithm, err := previous.Sign(rotationData(alias, key))
+	if err != nil {
+		return nil, err
+	}
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), next, nil, nil, key)
+	if err != nil {
+		return nil, err
+	}
+	record.Meta = map[string]string{
+		META_PUBLIC_FORMAT:      strconv.FormatInt(int64(format), 10),
+		META_PREVIOUS_KEY:       base64.RawURLEncoding.EncodeToString(cryptogo.Hash(previousKey)),
+		META_PREVIOUS_SIGNATURE: base64.RawURLEncoding.EncodeToString(signature),
+		META_PREVIOUS_ALGORITHM: strconv.FormatInt(int64(algorithm), 10),
+	}
+	return record, nil
+}
+
+// ErrRotationNotPushed is returned along with the reference of a rotation which was mined, but could not be pushed to the network.
+// The rotation is held in the cache, and is published by the next push of the rotation channel.
+type ErrRotationNotPushed struct {
+	Alias string
+	Err   error
+}
+
+func (e ErrRotationNotPushed) Error() string {
+	return fmt.Sprintf("Rotation of %s was mined but could not be pushed: %s", e.Alias, e.Err)
+}
+
+// RotateKey writes a rotation record to the rotation channel, mines it, and pushes it to the network.
+// The node must hold the previous account of the alias.
+// Once mined the reference is always returned, if the push then fails it is returned with an ErrRotationNotPushed.
+func RotateKey(node bcgo.Node, next bcgo.Account, listener bcgo.MiningListener) (*bcgo.Reference, error) {
+	record, err := CreateRotationRecord(node.Account(), next)
+	if err != nil {
+		return nil, err
+	}
+	cache := node.Cache()
+	network := node.Network()
+	rotations, err := node.OpenChannel(ALIAS_ROTATION, OpenRotationChannel)
+	if err != nil {
+		return nil, err
+	}
+	if err := rotations.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	reference, err := bcgo.WriteRecord(ALIAS_ROTATION, cache, record)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := node.Mine(rotations, aliasgo.ALIAS_THRESHOLD, listener); err != nil {
+		return nil, err
+	}
+	if network != nil {
+		if err := rotations.Push(cache, network); err != nil {
+			return reference, ErrRotationNotPushed{
+				Alias: next.Alias(),
+				Err:   err,
+			}
+		}
+	}
+	return reference, nil
+}
+
+// KeyHistory returns the keys of the given alias, oldest first, starting with the registered key.
+// Each rotation is only accepted if it is signed by the key before it, which is parsed by the first of the codecs which supports it.
+func KeyHistory(codecs []KeyCodec, cache bcgo.Cache, network bcgo.Network, alias string) ([]*KeyVersion, error) {
+	aliases := aliasgo.OpenAliasChannel()
+	if err := aliases.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	r, a, err := aliasgo.Record(aliases, cache, network, alias)
+	if err != nil {
+		return nil, err
+	}
+	registration := &KeyVersion{
+		PublicKey:    a.PublicKey,
+		PublicFormat: a.PublicFormat,
+		Timestamp:    r.Timestamp,
+	}
+	if err := bcgo.Iterate(aliases.Name(), aliases.Head(), nil, cache, network, func(hash []byte, block *bcgo.Block) error {
+		for _, entry := range block.Entry {
+			if entry.Record.Creator == alias && entry.Record.Timestamp == r.Timestamp {
+				registration.URI = NewRecordURI(aliases.Name(), hash, entry.RecordHash)
+				return bcgo.StopIterationError{}
+			}
+		}
+		return nil
+	}); err != nil {
+		if _, ok := err.(bcgo.StopIterationError); !ok {
+			log.Println(err)
+		}
+	}
+	history := []*KeyVersion{registration}
+	rotations := OpenRotationChannel()
+	if err := rotations.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	if len(rotations.Head()) == 0 {
+		return history, nil
+	}
+	if err := bcgo.IterateChronologically(rotations.Name(), rotations.Head(), nil, cache, network, func(hash []byte, block *bcgo.Block) error {
+		for _, entry := range block.Entry {
+			if entry.Record.Creator != alias {
+				continue
+			}
+			current := history[len(history)-1]
+			next, err := verifyRotation(codecs, alias, current, entry.Record)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			next.URI = NewRecordURI(rotations.Name(), hash, entry.RecordHash)
+			history = append(history, next)
+		}
+		return nil
+	}); err != nil {
+		return history, err
+	}
+	return history, nil
+}
+
+// verifyRotation checks the rotation record was authorized by the current key, and returns the key it announces.
+func verifyRotation(codecs []KeyCodec, alias string, current *KeyVersion, record *bcgo.Record) (*KeyVersion, error) {
+	if record.Timestamp < current.Timestamp {
+		return nil, fmt.Errorf("Rotation of %s predates current key", alias)
+	}
+	previous, err := base64.RawURLEncoding.DecodeString(record.Meta[META_PREVIOUS_KEY])
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(previous, cryptogo.Hash(current.PublicKey)) {
+		return nil, fmt.Errorf("Rotation of %s does not follow current key", alias)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(record.Meta[META_PREVIOUS_SIGNATURE])
+	if err != nil {
+		return nil, err
+	}
+	algorithm, err := strconv.ParseInt(record.Meta[META_PREVIOUS_ALGORITHM], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	format, err := strconv.ParseInt(record.Meta[META_PUBLIC_FORMAT], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := ParseIdentity(codecs, alias, current.PublicKey, current.PublicFormat)
+	if err != nil {
+		return nil, err
+	}
+	if err := identity.Verify(rotationData(alias, record.Payload), signature, cryptogo.SignatureAlgorithm(algorithm)); err != nil {
+		return nil, fmt.Errorf("Rotation of %s has invalid signature: %s", alias, err)
+	}
+	return &KeyVersion{
+		PublicKey:    record.Payload,
+		PublicFormat: cryptogo.PublicKeyFormat(format),
+		Timestamp:    record.Timestamp,
+	}, nil
+}
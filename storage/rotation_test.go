@@ -0,0 +1,81 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo/account"
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+)
+
+func Test_KeyHistory(t *testing.T) {
+	n := makeNode(t, "Alice")
+	if err := aliasgo.Register(n, nil); err != nil {
+		t.Fatal(err)
+	}
+	codecs := []storage.KeyCodec{storage.NewRSAKeyCodec()}
+	history, err := storage.KeyHistory(codecs, n.Cache(), nil, "Alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("Incorrect history; expected 1, got %d", len(history))
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := account.NewRSA("Alice", key)
+	if _, err := storage.RotateKey(n, next, nil); err != nil {
+		t.Fatal(err)
+	}
+	_, want, err := next.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Rotated", func(t *testing.T) {
+		history, err := storage.KeyHistory(codecs, n.Cache(), nil, "Alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 2 {
+			t.Fatalf("Incorrect history; expected 2, got %d", len(history))
+		}
+		if !bytes.Equal(history[1].PublicKey, want) {
+			t.Fatalf("Incorrect key; expected rotated key")
+		}
+		if history[1].URI == nil || history[1].URI.Channel() != storage.ALIAS_ROTATION {
+			t.Fatalf("Incorrect URI; got %v", history[1].URI)
+		}
+	})
+	t.Run("UnsupportedKey", func(t *testing.T) {
+		// Rotations cannot be verified without a codec for the previous key
+		history, err := storage.KeyHistory(nil, n.Cache(), nil, "Alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 1 {
+			t.Fatalf("Incorrect history; expected 1, got %d", len(history))
+		}
+	})
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// KEY_TRANSFER_PREFIX starts each part of a key transferred offline.
+	KEY_TRANSFER_PREFIX = "bcfyne-key:"
+	// KEY_TRANSFER_PART_SIZE is the number of characters of the encoded key in each part.
+	KEY_TRANSFER_PART_SIZE = 512
+	// KEY_TRANSFER_MAX_PARTS is the most parts accepted in a transfer, far more than any key file needs.
+	KEY_TRANSFER_MAX_PARTS = 64
+)
+
+var (
+	ErrNotKeyTransfer        = errors.New("Not part of a key transfer")
+	ErrKeyTransferMismatch   = errors.New("Part belongs to a different key transfer")
+	ErrKeyTransferIncomplete = errors.New("Key transfer is incomplete")
+	ErrKeyTransferCorrupt    = errors.New("Key transfer is corrupt")
+)
+
+// EncodeKeyTransfer splits the key file, encrypted with the password of the key, into parts small enough to fit in a QR code.
+// Each part is of the form prefix index/count/alias/checksum/part checksum/data so the parts can be collected in any order,
+// and a misread part is rejected as soon as it is added rather than once the whole key is reassembled.
+func EncodeKeyTransfer(alias string, data []byte) []string {
+	checksum := keyTransferChecksum(data)
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	count := (len(encoded) + KEY_TRANSFER_PART_SIZE - 1) / KEY_TRANSFER_PART_SIZE
+	var parts []string
+	for i := 0; i < count; i++ {
+		end := (i + 1) * KEY_TRANSFER_PART_SIZE
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		data := encoded[i*KEY_TRANSFER_PART_SIZE : end]
+		parts = append(parts, fmt.Sprintf("%s%d/%d/%s/%s/%s/%s", KEY_TRANSFER_PREFIX, i+1, count, url.PathEscape(alias), checksum, keyTransferChecksum([]byte(data)), data))
+	}
+	return parts
+}
+
+func keyTransferChecksum(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:8])
+}
+
+// KeyTransfer collects the parts of a key transferred offline.
+type KeyTransfer struct {
+	Alias    string
+	checksum string
+	parts    []string
+}
+
+// Add adds the part to the transfer, the first part added determines the alias and number of parts.
+// A part which does not match its checksum is rejected with ErrKeyTransferCorrupt.
+func (t *KeyTransfer) Add(part string) error {
+	if !strings.HasPrefix(part, KEY_TRANSFER_PREFIX) {
+		return ErrNotKeyTransfer
+	}
+	fields := strings.SplitN(strings.TrimPrefix(part, KEY_TRANSFER_PREFIX), "/", 6)
+	if len(fields) != 6 {
+		return ErrNotKeyTransfer
+	}
+	index, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return ErrNotKeyTransfer
+	}
+	count, err := strconv.Atoi(fields[1])
+	if err != nil || count < 1 || count > KEY_TRANSFER_MAX_PARTS || index < 1 || index > count {
+		return ErrNotKeyTransfer
+	}
+	alias, err := url.PathUnescape(fields[2])
+	if err != nil {
+		return ErrNotKeyTransfer
+	}
+	if keyTransferChecksum([]byte(fields[5])) != fields[4] {
+		return ErrKeyTransferCorrupt
+	}
+	if t.parts == nil {
+		t.Alias = alias
+		t.checksum = fields[3]
+		t.parts = make([]string, count)
+	} else if alias != t.Alias || fields[3] != t.checksum || count != len(t.parts) {
+		return ErrKeyTransferMismatch
+	}
+	t.parts[index-1] = fields[5]
+	return nil
+}
+
+// Count returns the number of parts received and the number expected.
+func (t *KeyTransfer) Count() (int, int) {
+	received := 0
+	for _, p := range t.parts {
+		if p != "" {
+			received++
+		}
+	}
+	return received, len(t.parts)
+}
+
+// Missing returns the indices, starting at 1, of the parts not yet received.
+func (t *KeyTransfer) Missing() []int {
+	var missing []int
+	for i, p := range t.parts {
+		if p == "" {
+			missing = append(missing, i+1)
+		}
+	}
+	return missing
+}
+
+// IsComplete returns true if at least one part has been added and none are missing.
+func (t *KeyTransfer) IsComplete() bool {
+	return len(t.parts) > 0 && len(t.Missing()) == 0
+}
+
+// Data reassembles the parts into the key file, which is still encrypted with the password of the key.
+func (t *KeyTransfer) Data() ([]byte, error) {
+	if !t.IsComplete() {
+		return nil, ErrKeyTransferIncomplete
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.Join(t.parts, ""))
+	if err != nil {
+		return nil, err
+	}
+	if keyTransferChecksum(data) != t.checksum {
+		return nil, ErrKeyTransferCorrupt
+	}
+	return data, nil
+}
+
+// Reset discards the parts added so far, so a transfer of another key can be started.
+func (t *KeyTransfer) Reset() {
+	t.Alias = ""
+	t.checksum = ""
+	t.parts = nil
+}
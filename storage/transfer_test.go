@@ -0,0 +1,93 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func Test_KeyTransfer(t *testing.T) {
+	data := bytes.Repeat([]byte("Hello World"), 100)
+	parts := storage.EncodeKeyTransfer("Alice", data)
+	if len(parts) < 2 {
+		t.Fatalf("Incorrect parts; expected at least 2, got %d", len(parts))
+	}
+	t.Run("Complete", func(t *testing.T) {
+		transfer := &storage.KeyTransfer{}
+		for i := len(parts) - 1; i >= 0; i-- {
+			if err := transfer.Add(parts[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		got, err := transfer.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, got) {
+			t.Fatal("Incorrect data")
+		}
+	})
+	t.Run("CorruptPart", func(t *testing.T) {
+		transfer := &storage.KeyTransfer{}
+		part := parts[0]
+		corrupt := part[:len(part)-1] + strings.ToUpper(part[len(part)-1:])
+		if corrupt == part {
+			corrupt = part[:len(part)-1] + "0"
+		}
+		if err := transfer.Add(corrupt); err != storage.ErrKeyTransferCorrupt {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrKeyTransferCorrupt, err)
+		}
+		if received, _ := transfer.Count(); received != 0 {
+			t.Fatalf("Incorrect received; expected 0, got %d", received)
+		}
+	})
+	t.Run("TooManyParts", func(t *testing.T) {
+		transfer := &storage.KeyTransfer{}
+		// Each part checksum covers only its data, so the count can be changed without corrupting the part
+		fields := strings.SplitN(strings.TrimPrefix(parts[0], storage.KEY_TRANSFER_PREFIX), "/", 3)
+		part := storage.KEY_TRANSFER_PREFIX + "1/" + strconv.Itoa(storage.KEY_TRANSFER_MAX_PARTS+1) + "/" + fields[2]
+		if err := transfer.Add(part); err != storage.ErrNotKeyTransfer {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrNotKeyTransfer, err)
+		}
+	})
+	t.Run("Reset", func(t *testing.T) {
+		transfer := &storage.KeyTransfer{}
+		if err := transfer.Add(parts[0]); err != nil {
+			t.Fatal(err)
+		}
+		other := storage.EncodeKeyTransfer("Bob", data)
+		if err := transfer.Add(other[1]); err != storage.ErrKeyTransferMismatch {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", storage.ErrKeyTransferMismatch, err)
+		}
+		transfer.Reset()
+		for _, p := range other {
+			if err := transfer.Add(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if transfer.Alias != "Bob" {
+			t.Fatalf("Incorrect alias; expected 'Bob', got '%s'", transfer.Alias)
+		}
+		if !transfer.IsComplete() {
+			t.Fatal("Expected transfer to be complete")
+		}
+	})
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"aletheiaware.com/bcgo"
+	"fyne.io/fyne/v2"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	PREFERENCE_WATCHED_CHANNELS = "watched_channels"
+	PREFERENCE_SYNC_INTERVAL    = "sync_interval"
+	PREFERENCE_SYNC_PUSH        = "sync_push"
+
+	// DEFAULT_SYNC_INTERVAL is the number of minutes between syncs of watched channels.
+	DEFAULT_SYNC_INTERVAL = 15
+)
+
+// WatchedChannels returns the sorted names of the channels synced in the background.
+func WatchedChannels(p fyne.Preferences) []string {
+	return bcgo.SplitRemoveEmpty(p.String(PREFERENCE_WATCHED_CHANNELS), ",")
+}
+
+// SetWatchedChannels replaces the channels synced in the background.
+func SetWatchedChannels(p fyne.Preferences, channels []string) {
+	unique := make(map[string]bool)
+	var names []string
+	for _, c := range channels {
+		c = strings.TrimSpace(c)
+		if c == "" || unique[c] {
+			continue
+		}
+		unique[c] = true
+		names = append(names, c)
+	}
+	sort.Strings(names)
+	p.SetString(PREFERENCE_WATCHED_CHANNELS, strings.Join(names, ","))
+}
+
+// IsWatched returns true if the channel is synced in the background.
+func IsWatched(p fyne.Preferences, channel string) bool {
+	for _, c := range WatchedChannels(p) {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch adds the channel to those synced in the background.
+func Watch(p fyne.Preferences, channel string) {
+	SetWatchedChannels(p, append(WatchedChannels(p), channel))
+}
+
+// Unwatch removes the channel from those synced in the background.
+func Unwatch(p fyne.Preferences, channel string) {
+	var channels []string
+	for _, c := range WatchedChannels(p) {
+		if c != channel {
+			channels = append(channels, c)
+		}
+	}
+	SetWatchedChannels(p, channels)
+}
+
+// SyncInterval returns the time between syncs of watched channels.
+func SyncInterval(p fyne.Preferences) time.Duration {
+	return time.Duration(p.IntWithFallback(PREFERENCE_SYNC_INTERVAL, DEFAULT_SYNC_INTERVAL)) * time.Minute
+}
+
+// SetSyncInterval sets the number of minutes between syncs of watched channels.
+func SetSyncInterval(p fyne.Preferences, minutes int) {
+	p.SetInt(PREFERENCE_SYNC_INTERVAL, minutes)
+}
+
+// SyncPush returns true if watched channels are pushed as well as pulled.
+func SyncPush(p fyne.Preferences) bool {
+	return p.Bool(PREFERENCE_SYNC_PUSH)
+}
+
+// SetSyncPush sets whether watched channels are pushed as well as pulled.
+func SetSyncPush(p fyne.Preferences, push bool) {
+	p.SetBool(PREFERENCE_SYNC_PUSH, push)
+}
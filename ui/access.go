@@ -22,6 +22,7 @@ import (
 	"aletheiaware.com/bcgo"
 	"encoding/base64"
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
 )
 
@@ -29,6 +30,7 @@ type AccessView struct {
 	widget.Form
 	ui                  UI
 	client              bcclientgo.BCClient
+	warning             *fyne.Container
 	alias               *Link
 	secretKey           *widget.Label
 	encryptionAlgorithm *widget.Label
@@ -36,8 +38,9 @@ type AccessView struct {
 
 func NewAccessView(ui UI, client bcclientgo.BCClient) *AccessView {
 	v := &AccessView{
-		ui:     ui,
-		client: client,
+		ui:      ui,
+		client:  client,
+		warning: container.NewVBox(),
 		alias: &Link{
 			Hyperlink: widget.Hyperlink{
 				TextStyle: fyne.TextStyle{
@@ -63,6 +66,7 @@ func NewAccessView(ui UI, client bcclientgo.BCClient) *AccessView {
 	v.alias.ExtendBaseWidget(v.alias)
 	v.secretKey.ExtendBaseWidget(v.secretKey)
 	v.encryptionAlgorithm.ExtendBaseWidget(v.encryptionAlgorithm)
+	v.Append("", v.warning)
 	v.Append("Alias", v.alias)
 	v.Append("Key", v.secretKey)
 	v.Append("Encryption", v.encryptionAlgorithm)
@@ -70,10 +74,20 @@ func NewAccessView(ui UI, client bcclientgo.BCClient) *AccessView {
 }
 
 func (v *AccessView) SetAccess(access *bcgo.Record_Access) {
+	v.setAccess(access, newPinnedKeyChecker(v.ui, v.client))
+}
+
+func (v *AccessView) setAccess(access *bcgo.Record_Access, checker *pinnedKeyChecker) {
 	v.alias.SetText(access.Alias)
 	v.alias.OnTapped = func() {
 		v.ui.ShowURI(v.client, storage.NewAliasURI(access.Alias))
 	}
+	var warnings []fyne.CanvasObject
+	if w := checker.Check(access.Alias); w != nil {
+		warnings = append(warnings, w)
+	}
+	v.warning.Objects = warnings
+	v.warning.Refresh()
 	v.secretKey.SetText(base64.RawURLEncoding.EncodeToString(access.SecretKey))
 	v.encryptionAlgorithm.SetText(access.EncryptionAlgorithm.String())
 	v.Refresh()
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+	"sync"
+	"time"
+)
+
+// AccessCodeList shows exported access codes and when each expires.
+type AccessCodeList struct {
+	fyne.Container
+	OnForget func(*storage.AccessCode)
+	lock     sync.Mutex
+	codes    []*storage.AccessCode
+	statuses []*widget.Label
+}
+
+func NewAccessCodeList() *AccessCodeList {
+	l := &AccessCodeList{}
+	l.Layout = layout.NewVBoxLayout()
+	return l
+}
+
+// SetCodes replaces the codes shown.
+func (l *AccessCodeList) SetCodes(codes []*storage.AccessCode) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.codes = codes
+	l.statuses = nil
+	l.Objects = nil
+	if len(codes) == 0 {
+		l.Objects = append(l.Objects, widget.NewLabel("No access codes exported"))
+	}
+	now := time.Now()
+	for _, c := range codes {
+		code := c
+		status := widget.NewLabel(code.Status(now))
+		l.statuses = append(l.statuses, status)
+		buttons := container.NewHBox()
+		if l.OnForget != nil {
+			buttons.Add(widget.NewButton("Forget", func() {
+				l.OnForget(code)
+			}))
+		}
+		l.Objects = append(l.Objects, widget.NewCard(code.Alias, code.Host, container.NewBorder(nil, nil, nil, buttons, container.NewVBox(
+			&widget.Label{
+				Text:      code.Masked,
+				TextStyle: fyne.TextStyle{Monospace: true},
+				Wrapping:  fyne.TextWrapBreak,
+			},
+			widget.NewLabel("Exported "+bcgo.TimestampToString(uint64(code.Created.UnixNano()))),
+			status,
+		))))
+	}
+	l.Refresh()
+}
+
+// Tick updates the status of each code.
+func (l *AccessCodeList) Tick(now time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for i, c := range l.codes {
+		l.statuses[i].SetText(c.Status(now))
+	}
+}
+
+// TickUntilClosed calls tick every second until the dialog is closed.
+func TickUntilClosed(d dialog.Dialog, tick func(time.Time)) {
+	ticker := time.NewTicker(time.Second)
+	done := make(chan struct{})
+	var once sync.Once
+	d.SetOnClosed(func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	})
+	go func() {
+		for {
+			select {
+			case now := <-ticker.C:
+				tick(now)
+			case <-done:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+type ChangePassword struct {
+	Alias                *widget.Label
+	Password             *widget.Entry
+	NewPassword          *widget.Entry
+	Confirm              *widget.Entry
+	ChangePasswordButton *widget.Button
+}
+
+func NewChangePassword(alias string) *ChangePassword {
+	s := &ChangePassword{
+		Alias:                widget.NewLabel(alias),
+		Password:             widget.NewPasswordEntry(),
+		NewPassword:          widget.NewPasswordEntry(),
+		Confirm:              widget.NewPasswordEntry(),
+		ChangePasswordButton: widget.NewButton("Change Password", nil),
+	}
+	s.Password.PlaceHolder = "Current Password"
+	s.Password.Wrapping = fyne.TextWrapOff
+	s.NewPassword.PlaceHolder = "New Password"
+	s.NewPassword.Wrapping = fyne.TextWrapOff
+	s.Confirm.PlaceHolder = "Confirm New Password"
+	s.Confirm.Wrapping = fyne.TextWrapOff
+	s.ChangePasswordButton.Importance = widget.HighImportance
+	return s
+}
+
+func (s *ChangePassword) CanvasObject() fyne.CanvasObject {
+	return container.NewGridWithColumns(1,
+		s.Alias,
+		s.Password,
+		s.NewPassword,
+		s.Confirm,
+		layout.NewSpacer(),
+		s.ChangePasswordButton,
+	)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+type ImportKeyCodes struct {
+	Status        *widget.Label
+	AddCodeButton *widget.Button
+	ResetButton   *widget.Button
+	Password      *widget.Entry
+	ImportButton  *widget.Button
+}
+
+func NewImportKeyCodes() *ImportKeyCodes {
+	i := &ImportKeyCodes{
+		Status:        widget.NewLabel("No QR codes added"),
+		AddCodeButton: widget.NewButton("Add QR Code Image", nil),
+		ResetButton:   widget.NewButton("Clear QR Codes", nil),
+		Password:      widget.NewPasswordEntry(),
+		ImportButton:  widget.NewButton("Import Key", nil),
+	}
+	i.Status.Wrapping = fyne.TextWrapWord
+	i.Password.PlaceHolder = "Password"
+	i.Password.Wrapping = fyne.TextWrapOff
+	i.ImportButton.Importance = widget.HighImportance
+	return i
+}
+
+func (i *ImportKeyCodes) CanvasObject() fyne.CanvasObject {
+	return container.NewGridWithColumns(1,
+		i.Status,
+		i.AddCodeButton,
+		i.ResetButton,
+		i.Password,
+		layout.NewSpacer(),
+		i.ImportButton,
+	)
+}
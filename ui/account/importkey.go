@@ -24,6 +24,7 @@ import (
 )
 
 type ImportKey struct {
+	Host            *widget.SelectEntry
 	Alias           *widget.Entry
 	Access          *widget.Entry
 	ImportKeyButton *widget.Button
@@ -31,10 +32,13 @@ type ImportKey struct {
 
 func NewImportKey() *ImportKey {
 	i := &ImportKey{
+		Host:            widget.NewSelectEntry(nil),
 		Alias:           widget.NewEntry(),
 		Access:          widget.NewEntry(),
 		ImportKeyButton: widget.NewButton("Import Key", nil),
 	}
+	i.Host.PlaceHolder = "Host"
+	i.Host.Wrapping = fyne.TextWrapOff
 	i.Alias.PlaceHolder = "Alias"
 	i.Alias.Wrapping = fyne.TextWrapOff
 	i.Access.PlaceHolder = "Access Code"
@@ -45,6 +49,7 @@ func NewImportKey() *ImportKey {
 
 func (i *ImportKey) CanvasObject() fyne.CanvasObject {
 	return container.NewGridWithColumns(1,
+		i.Host,
 		i.Alias,
 		i.Access,
 		layout.NewSpacer(),
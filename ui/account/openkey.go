@@ -0,0 +1,64 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+import (
+	"aletheiaware.com/bcfynego/ui"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+type OpenKeyFile struct {
+	Alias          *widget.Entry
+	File           *widget.Entry
+	Picker         *ui.FilePicker
+	Password       *widget.Entry
+	Copy           *widget.Check
+	OpenFileButton *widget.Button
+}
+
+func NewOpenKeyFile(window fyne.Window) *OpenKeyFile {
+	o := &OpenKeyFile{
+		Alias:          widget.NewEntry(),
+		File:           widget.NewEntry(),
+		Password:       widget.NewPasswordEntry(),
+		Copy:           widget.NewCheck("Copy into Keystore", nil),
+		OpenFileButton: widget.NewButton("Open Key File", nil),
+	}
+	o.Picker = ui.NewFilePicker(window, o.File)
+	o.Alias.PlaceHolder = "Alias"
+	o.Alias.Wrapping = fyne.TextWrapOff
+	o.File.PlaceHolder = "Key File"
+	o.File.Wrapping = fyne.TextWrapOff
+	o.Password.PlaceHolder = "Password"
+	o.Password.Wrapping = fyne.TextWrapOff
+	o.OpenFileButton.Importance = widget.HighImportance
+	return o
+}
+
+func (o *OpenKeyFile) CanvasObject() fyne.CanvasObject {
+	return container.NewGridWithColumns(1,
+		o.Alias,
+		container.NewBorder(nil, nil, nil, o.Picker, o.File),
+		o.Password,
+		o.Copy,
+		layout.NewSpacer(),
+		o.OpenFileButton,
+	)
+}
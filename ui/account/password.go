@@ -0,0 +1,198 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account
+
+import (
+	"aletheiaware.com/bcfynego/ui/data"
+	"aletheiaware.com/cryptogo"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Password strengths, as the number of bits of entropy.
+const (
+	ENTROPY_WEAK   = 36
+	ENTROPY_FAIR   = 60
+	ENTROPY_STRONG = 80
+	ENTROPY_MAX    = 128
+)
+
+var (
+	ErrPasswordCommon        = errors.New("Password is too common")
+	ErrPasswordContainsAlias = errors.New("Password contains alias")
+)
+
+// ErrPasswordTooWeak is returned when a password has less entropy than the policy requires.
+type ErrPasswordTooWeak struct {
+	Entropy, Min float64
+}
+
+func (e ErrPasswordTooWeak) Error() string {
+	return fmt.Sprintf("Password too weak; %.0f bits of entropy, minimum %.0f", e.Entropy, e.Min)
+}
+
+// PasswordPolicy decides which passwords are accepted when signing up.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// MinEntropy is the minimum estimated bits of entropy.
+	MinEntropy float64
+	// RejectCommon rejects passwords built from a word in the common password list.
+	RejectCommon bool
+	// RejectAlias rejects passwords containing the alias, otherwise it is only a warning.
+	RejectAlias bool
+}
+
+// DefaultPasswordPolicy returns the policy used when none is given.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:    cryptogo.MIN_PASSWORD,
+		RejectCommon: true,
+	}
+}
+
+// Check returns an error if the password of the alias does not meet the policy, along with any warnings.
+func (p *PasswordPolicy) Check(alias, password string) (warnings []string, err error) {
+	if size := len(password); size < p.MinLength {
+		return nil, cryptogo.ErrPasswordTooShort{Size: size, Min: p.MinLength}
+	}
+	if IsCommonPassword(password) {
+		if p.RejectCommon {
+			return nil, ErrPasswordCommon
+		}
+		warnings = append(warnings, ErrPasswordCommon.Error())
+	}
+	if ContainsAlias(alias, password) {
+		if p.RejectAlias {
+			return nil, ErrPasswordContainsAlias
+		}
+		warnings = append(warnings, ErrPasswordContainsAlias.Error())
+	}
+	if entropy := PasswordEntropy(password); entropy < p.MinEntropy {
+		return nil, ErrPasswordTooWeak{Entropy: entropy, Min: p.MinEntropy}
+	}
+	return warnings, nil
+}
+
+var (
+	commonOnce      sync.Once
+	commonPasswords map[string]bool
+)
+
+func loadCommonPasswords() {
+	commonPasswords = make(map[string]bool)
+	for _, line := range strings.Split(string(data.CommonPasswords.StaticContent), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			commonPasswords[line] = true
+		}
+	}
+}
+
+// IsCommonPassword returns true if the password, ignoring case and any digits or symbols around it, is in the common password list.
+func IsCommonPassword(password string) bool {
+	commonOnce.Do(loadCommonPasswords)
+	p := strings.ToLower(password)
+	if commonPasswords[p] {
+		return true
+	}
+	base := strings.TrimFunc(p, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return base != "" && commonPasswords[base]
+}
+
+// ContainsAlias returns true if the password contains the alias, ignoring case.
+func ContainsAlias(alias, password string) bool {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+	return alias != "" && strings.Contains(strings.ToLower(password), alias)
+}
+
+// PasswordEntropy estimates the bits of entropy of the password from the size of the character classes it uses.
+// Characters repeating or continuing a sequence from the previous character only count for one bit.
+// Common passwords only count for the size of the common password list.
+func PasswordEntropy(password string) float64 {
+	if IsCommonPassword(password) {
+		return math.Log2(float64(len(commonPasswords)))
+	}
+	var lower, upper, digit, symbol, other bool
+	runes := []rune(password)
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII && unicode.IsPrint(r):
+			symbol = true
+		default:
+			other = true
+		}
+	}
+	pool := 0
+	for _, c := range []struct {
+		used bool
+		size int
+	}{
+		{lower, 26},
+		{upper, 26},
+		{digit, 10},
+		{symbol, 33},
+		{other, 100},
+	} {
+		if c.used {
+			pool += c.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	bits := math.Log2(float64(pool))
+	entropy := 0.0
+	for i, r := range runes {
+		if i > 0 {
+			d := r - runes[i-1]
+			if d >= -1 && d <= 1 {
+				entropy++
+				continue
+			}
+		}
+		entropy += bits
+	}
+	return entropy
+}
+
+// PasswordStrength returns a description of the given bits of entropy.
+func PasswordStrength(entropy float64) string {
+	switch {
+	case entropy < ENTROPY_WEAK:
+		return "Very Weak"
+	case entropy < ENTROPY_FAIR:
+		return "Weak"
+	case entropy < ENTROPY_STRONG:
+		return "Fair"
+	case entropy < ENTROPY_MAX:
+		return "Strong"
+	default:
+		return "Very Strong"
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package account_test
+
+import (
+	"aletheiaware.com/bcfynego/ui/account"
+	"aletheiaware.com/cryptogo"
+	"math"
+	"testing"
+)
+
+func Test_PasswordEntropy(t *testing.T) {
+	for name, tt := range map[string]struct {
+		password string
+		expected float64
+	}{
+		"empty":    {"", 0},
+		"repeated": {"q7777", 2*math.Log2(26+10) + 3},
+		"sequence": {"q6789", 2*math.Log2(26+10) + 3},
+		"classes":  {"aZ3!", 4 * math.Log2(26+26+10+33)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := account.PasswordEntropy(tt.password); math.Abs(got-tt.expected) > 1e-9 {
+				t.Fatalf("Incorrect entropy; expected %f, got %f", tt.expected, got)
+			}
+		})
+	}
+	t.Run("common", func(t *testing.T) {
+		if got := account.PasswordEntropy("password"); got >= account.ENTROPY_WEAK {
+			t.Fatalf("Incorrect entropy; expected less than %d, got %f", account.ENTROPY_WEAK, got)
+		}
+	})
+	t.Run("strong", func(t *testing.T) {
+		if got := account.PasswordEntropy("Tq8#vL2m!Xr9@Kw4"); got < account.ENTROPY_STRONG {
+			t.Fatalf("Incorrect entropy; expected at least %d, got %f", account.ENTROPY_STRONG, got)
+		}
+	})
+}
+
+func Test_IsCommonPassword(t *testing.T) {
+	for password, expected := range map[string]bool{
+		"password":         true,
+		"PASSWORD":         true,
+		"Password123!":     true,
+		"123456":           true,
+		"trustno1":         true,
+		"sunshine":         true,
+		"Tq8#vL2m!Xr9@Kw4": false,
+		// Comments in the list are not passwords
+		"# Common passwords, most frequent first, one per line.": false,
+	} {
+		t.Run(password, func(t *testing.T) {
+			if got := account.IsCommonPassword(password); got != expected {
+				t.Fatalf("Incorrect common; expected %t, got %t", expected, got)
+			}
+		})
+	}
+}
+
+func Test_PasswordPolicy_Check(t *testing.T) {
+	t.Run("TooShort", func(t *testing.T) {
+		_, err := account.DefaultPasswordPolicy().Check("Alice", "Tq8#vL2m")
+		if _, ok := err.(cryptogo.ErrPasswordTooShort); !ok {
+			t.Fatalf("Incorrect error; expected ErrPasswordTooShort, got '%v'", err)
+		}
+	})
+	t.Run("Common", func(t *testing.T) {
+		if _, err := account.DefaultPasswordPolicy().Check("Alice", "password1234"); err != account.ErrPasswordCommon {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", account.ErrPasswordCommon, err)
+		}
+		policy := account.DefaultPasswordPolicy()
+		policy.RejectCommon = false
+		warnings, err := policy.Check("Alice", "password1234")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warnings) != 1 || warnings[0] != account.ErrPasswordCommon.Error() {
+			t.Fatalf("Incorrect warnings; expected '%s', got '%v'", account.ErrPasswordCommon, warnings)
+		}
+	})
+	t.Run("ContainsAlias", func(t *testing.T) {
+		policy := account.DefaultPasswordPolicy()
+		warnings, err := policy.Check("Alice", "Tq8#ALICE!Xr9@Kw4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warnings) != 1 || warnings[0] != account.ErrPasswordContainsAlias.Error() {
+			t.Fatalf("Incorrect warnings; expected '%s', got '%v'", account.ErrPasswordContainsAlias, warnings)
+		}
+		policy.RejectAlias = true
+		if _, err := policy.Check("Alice", "Tq8#ALICE!Xr9@Kw4"); err != account.ErrPasswordContainsAlias {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", account.ErrPasswordContainsAlias, err)
+		}
+	})
+	t.Run("TooWeak", func(t *testing.T) {
+		policy := account.DefaultPasswordPolicy()
+		policy.MinEntropy = account.ENTROPY_STRONG
+		_, err := policy.Check("Alice", "abcdefghijklmn")
+		if _, ok := err.(account.ErrPasswordTooWeak); !ok {
+			t.Fatalf("Incorrect error; expected ErrPasswordTooWeak, got '%v'", err)
+		}
+		if _, err := policy.Check("Alice", "Tq8#vL2m!Xr9@Kw4"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -21,28 +21,49 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
+	"math"
+	"strings"
 )
 
 type SignUp struct {
 	Alias        *widget.Entry
 	Password     *widget.Entry
+	Strength     *widget.ProgressBar
+	Warning      *widget.Label
 	Confirm      *widget.Entry
+	Algorithm    *widget.Select
 	SignUpButton *widget.Button
+	Policy       *PasswordPolicy
 }
 
 func NewSignUp() *SignUp {
 	s := &SignUp{
 		Alias:        widget.NewEntry(),
 		Password:     widget.NewPasswordEntry(),
+		Strength:     widget.NewProgressBar(),
+		Warning:      widget.NewLabel(""),
 		Confirm:      widget.NewPasswordEntry(),
+		Algorithm:    widget.NewSelect(nil, nil),
 		SignUpButton: widget.NewButton("Sign Up", nil),
+		Policy:       DefaultPasswordPolicy(),
 	}
 	s.Alias.PlaceHolder = "Alias"
 	s.Alias.Wrapping = fyne.TextWrapOff
 	s.Password.PlaceHolder = "Password"
 	s.Password.Wrapping = fyne.TextWrapOff
+	s.Password.OnChanged = func(string) {
+		s.UpdateStrength()
+	}
+	s.Alias.OnChanged = func(string) {
+		s.UpdateStrength()
+	}
+	s.Strength.Max = ENTROPY_MAX
+	s.Warning.Wrapping = fyne.TextWrapWord
+	s.UpdateStrength()
 	s.Confirm.PlaceHolder = "Confirm Password"
 	s.Confirm.Wrapping = fyne.TextWrapOff
+	s.Algorithm.PlaceHolder = "Key Algorithm"
+	s.Algorithm.Hide()
 	s.SignUpButton.Importance = widget.HighImportance
 	return s
 }
@@ -51,8 +72,62 @@ func (s *SignUp) CanvasObject() fyne.CanvasObject {
 	return container.NewGridWithColumns(1,
 		s.Alias,
 		s.Password,
+		s.Strength,
+		s.Warning,
 		s.Confirm,
+		s.Algorithm,
 		layout.NewSpacer(),
 		s.SignUpButton,
 	)
 }
+
+// SetAlgorithms sets the key algorithms offered, selecting the first.
+// The choice is only shown when there is more than one algorithm.
+func (s *SignUp) SetAlgorithms(algorithms []string) {
+	s.Algorithm.Options = algorithms
+	if len(algorithms) > 0 {
+		s.Algorithm.SetSelected(algorithms[0])
+	}
+	if len(algorithms) > 1 {
+		s.Algorithm.Show()
+	} else {
+		s.Algorithm.Hide()
+	}
+}
+
+// UpdateStrength shows the strength of the password, and any reason it does not meet the policy.
+func (s *SignUp) UpdateStrength() {
+	password := s.Password.Text
+	entropy := PasswordEntropy(password)
+	s.Strength.TextFormatter = func() string {
+		if password == "" {
+			return ""
+		}
+		return PasswordStrength(entropy)
+	}
+	s.Strength.SetValue(math.Min(entropy, ENTROPY_MAX))
+	if password == "" {
+		s.Warning.SetText("")
+		s.Warning.Hide()
+		return
+	}
+	warnings, err := s.Validate()
+	if err != nil {
+		warnings = append([]string{err.Error()}, warnings...)
+	}
+	s.Warning.SetText(strings.Join(warnings, "\n"))
+	if len(warnings) == 0 {
+		s.Warning.Hide()
+	} else {
+		s.Warning.Show()
+	}
+}
+
+// Validate checks the password against the policy, returning an error if it is not met, along with any warnings.
+func (s *SignUp) Validate() ([]string, error) {
+	policy := s.Policy
+	if policy == nil {
+		policy = DefaultPasswordPolicy()
+	}
+	return policy.Check(s.Alias.Text, s.Password.Text)
+}
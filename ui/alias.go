@@ -20,10 +20,28 @@ import (
 	"aletheiaware.com/aliasgo"
 	"aletheiaware.com/bcclientgo"
 	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"encoding/base64"
+	"errors"
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
+	"log"
 )
 
+var errFound = errors.New("found")
+
+// IdentityForAlias returns an identity holding the current public key of the given alias, following any rotations of the registered key.
+// The key is parsed by the first of the codecs which supports it.
+func IdentityForAlias(codecs []storage.KeyCodec, cache bcgo.Cache, network bcgo.Network, alias string) (bcgo.Identity, error) {
+	history, err := storage.KeyHistory(codecs, cache, network, alias)
+	if err != nil {
+		return nil, err
+	}
+	current := history[len(history)-1]
+	return storage.ParseIdentity(codecs, alias, current.PublicKey, current.PublicFormat)
+}
+
 type AliasLabel struct {
 	widget.Label
 }
@@ -47,28 +65,66 @@ func (a *AliasLabel) SetAlias(alias string) {
 
 type AliasView struct {
 	widget.Form
-	ui        UI
-	client    bcclientgo.BCClient
-	timestamp *TimestampLabel
-	alias     *AliasLabel
-	key       *KeyLabel
+	ui           UI
+	client       bcclientgo.BCClient
+	warning      *fyne.Container
+	nickname     *widget.Entry
+	notes        *widget.Entry
+	save         *widget.Button
+	timestamp    *TimestampLabel
+	alias        *AliasLabel
+	key          *KeyLabel
+	registration *Link
+	history      *fyne.Container
+	mined        *PageView
+	created      *PageView
+	shared       *PageView
 }
 
 func NewAliasView(ui UI, client bcclientgo.BCClient) *AliasView {
 	v := &AliasView{
 		ui:        ui,
 		client:    client,
+		warning:   container.NewVBox(),
+		nickname:  widget.NewEntry(),
+		notes:     widget.NewMultiLineEntry(),
+		save:      widget.NewButton("Save", nil),
 		timestamp: NewTimestampLabel(0),
 		alias:     NewAliasLabel(""),
 		key:       NewKeyLabel(nil),
+		registration: &Link{
+			Hyperlink: widget.Hyperlink{
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+				Wrapping: fyne.TextWrapBreak,
+			},
+		},
+		history: container.NewVBox(),
+		mined:   NewPageView(),
+		created: NewPageView(),
+		shared:  NewPageView(),
 	}
 	v.ExtendBaseWidget(v)
 	v.alias.ExtendBaseWidget(v.alias)
-	v.key.ExtendBaseWidget(v.key)
 	v.timestamp.ExtendBaseWidget(v.timestamp)
+	v.registration.ExtendBaseWidget(v.registration)
+	v.nickname.PlaceHolder = "Nickname"
+	v.notes.PlaceHolder = "Notes"
+	v.notes.Wrapping = fyne.TextWrapWord
+	v.save.Disable()
+	v.Append("", v.warning)
 	v.Append("Timestamp", v.timestamp)
 	v.Append("Alias", v.alias)
 	v.Append("Key", v.key)
+	v.Append("Nickname", v.nickname)
+	v.Append("Notes", v.notes)
+	v.Append("", v.save)
+	v.Append("Registration", v.registration)
+	v.Append("Key History", v.history)
+	v.Append("Blocks Mined", v.mined)
+	v.Append("Records Created", v.created)
+	v.Append("Records Shared", v.shared)
 	return v
 }
 
@@ -90,9 +146,150 @@ func (v *AliasView) SetURI(uri storage.AliasURI) error {
 	if err != nil {
 		return err
 	}
+	key := a.PublicKey
+	history, err := storage.KeyHistory(v.ui.KeyCodecs(), cache, network, alias)
+	if err != nil {
+		log.Println(err)
+	}
+	if len(history) > 0 {
+		key = history[len(history)-1].PublicKey
+	}
 	v.alias.SetText(alias)
-	v.key.SetKey(a.PublicKey)
+	v.key.SetKey(key)
 	v.timestamp.SetTimestamp(r.Timestamp)
+	v.setHistory(history)
+	if err := v.setContact(alias, key, history); err != nil {
+		log.Println(err)
+	}
+	if err := bcgo.Iterate(aliases.Name(), aliases.Head(), nil, cache, network, func(hash []byte, block *bcgo.Block) error {
+		for _, entry := range block.Entry {
+			if entry.Record.Creator == alias && entry.Record.Timestamp == r.Timestamp {
+				v.registration.SetText(base64.RawURLEncoding.EncodeToString(hash))
+				v.registration.OnTapped = func() {
+					v.ui.ShowURI(v.client, storage.NewBlockURI(aliases.Name(), hash))
+				}
+				return errFound
+			}
+		}
+		return nil
+	}); err != nil && err != errFound {
+		log.Println(err)
+	}
 	v.Refresh()
+	go v.loadActivity(alias)
+	return nil
+}
+
+// setHistory lists the keys used by the alias, newest first, with links to the records announcing them.
+func (v *AliasView) setHistory(history []*storage.KeyVersion) {
+	var objects []fyne.CanvasObject
+	for i := len(history) - 1; i >= 0; i-- {
+		version := history[i]
+		label := NewKeyLabel(version.PublicKey)
+		status := "Rotated"
+		if i == len(history)-1 {
+			status = "Current"
+		}
+		link := &Link{
+			Hyperlink: widget.Hyperlink{
+				Text: bcgo.TimestampToString(version.Timestamp) + " " + status,
+			},
+		}
+		if version.URI != nil {
+			uri := version.URI
+			link.OnTapped = func() {
+				v.ui.ShowURI(v.client, uri)
+			}
+		}
+		link.ExtendBaseWidget(link)
+		objects = append(objects, link, label)
+	}
+	v.history.Objects = objects
+	v.history.Refresh()
+}
+
+// setContact checks the key against the contact book, pinning it on first sight, and shows the local nickname and notes.
+// A key reached from the pinned key by signed rotations is pinned in its place without a warning.
+func (v *AliasView) setContact(alias string, key []byte, history []*storage.KeyVersion) error {
+	contacts, err := v.ui.ContactBook(v.client)
+	if err != nil {
+		return err
+	}
+	var warnings []fyne.CanvasObject
+	err = contacts.Check(alias, key)
+	if changed, ok := err.(storage.ErrKeyChanged); ok && storage.FollowsRotation(changed.Pinned, history) {
+		if err := contacts.Pin(alias, key); err != nil {
+			return err
+		}
+	} else if ok {
+		warnings = append(warnings, NewKeyChangedWarning(changed), widget.NewButton("Trust New Key", func() {
+			if err := contacts.Pin(alias, key); err != nil {
+				v.ui.ShowError(err)
+				return
+			}
+			v.warning.Objects = nil
+			v.warning.Refresh()
+			v.Refresh()
+		}))
+	} else if err != nil {
+		return err
+	}
+	v.warning.Objects = warnings
+	v.warning.Refresh()
+	if c := contacts.Contact(alias); c != nil {
+		v.nickname.SetText(c.Nickname)
+		v.notes.SetText(c.Notes)
+	}
+	v.save.OnTapped = func() {
+		if err := contacts.Update(alias, v.nickname.Text, v.notes.Text); err != nil {
+			v.ui.ShowError(err)
+		}
+	}
+	v.save.Enable()
 	return nil
 }
+
+// loadActivity lists the activity of the given alias from the reference index, after indexing any newly cached blocks.
+func (v *AliasView) loadActivity(alias string) {
+	UpdateReferenceIndex(v.ui, v.client)
+	index, err := v.ui.ReferenceIndex(v.client)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	activity := index.Activity(alias)
+	for _, p := range []struct {
+		view      *PageView
+		backlinks []*storage.Backlink
+	}{
+		{v.mined, activity.Mined},
+		{v.created, activity.Created},
+		{v.shared, activity.Shared},
+	} {
+		backlinks := p.backlinks
+		p.view.SetItems(len(backlinks), func(i int) fyne.CanvasObject {
+			b := backlinks[i]
+			var u fyne.URI
+			if len(b.RecordHash) == 0 {
+				u = storage.NewBlockURI(b.Channel, b.BlockHash)
+			} else {
+				u = b.URI()
+			}
+			l := &Link{
+				Hyperlink: widget.Hyperlink{
+					Text: bcgo.TimestampToString(b.Timestamp) + " " + u.String(),
+					TextStyle: fyne.TextStyle{
+						Monospace: true,
+					},
+					Wrapping: fyne.TextWrapBreak,
+				},
+				OnTapped: func() {
+					v.ui.ShowURI(v.client, u)
+				},
+			}
+			l.ExtendBaseWidget(l)
+			return l
+		})
+	}
+	v.Refresh()
+}
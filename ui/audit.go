@@ -0,0 +1,319 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/cryptogo"
+	"bytes"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"io"
+)
+
+const (
+	ANOMALY_BLOCK_HASH       = "Block Hash"
+	ANOMALY_LINKAGE          = "Linkage"
+	ANOMALY_THRESHOLD        = "Threshold"
+	ANOMALY_RECORD_HASH      = "Record Hash"
+	ANOMALY_SIGNATURE        = "Signature"
+	ANOMALY_TIMESTAMP        = "Timestamp"
+	ANOMALY_UNKNOWN_IDENTITY = "Unknown Identity"
+)
+
+// Anomaly describes a single failed check, and the block or record it was found in.
+type Anomaly struct {
+	Kind    string
+	URI     storage.BlockURI
+	Message string
+}
+
+// AuditReport holds the result of walking a channel from head to genesis.
+type AuditReport struct {
+	Channel   string
+	Head      []byte
+	Threshold uint64
+	Blocks    int
+	Records   int
+	Complete  bool
+	Anomalies []*Anomaly
+}
+
+func (r *AuditReport) add(kind string, uri storage.BlockURI, format string, args ...interface{}) {
+	r.Anomalies = append(r.Anomalies, &Anomaly{
+		Kind:    kind,
+		URI:     uri,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// WriteText writes the report as plain text, one anomaly per line with a link to the offending block or record.
+func (r *AuditReport) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "Channel: %s\nHead: %s\nThreshold: %d\nBlocks: %d\nRecords: %d\nComplete: %t\nAnomalies: %d\n\n",
+		r.Channel,
+		storage.NewBlockURI(r.Channel, r.Head),
+		r.Threshold,
+		r.Blocks,
+		r.Records,
+		r.Complete,
+		len(r.Anomalies)); err != nil {
+		return err
+	}
+	for _, a := range r.Anomalies {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", a.Kind, a.URI, a.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyRecord checks the signature of the record against the public key of the given identity.
+// As in bcgo.CreateRecord, the signature covers the hash of the payload.
+func VerifyRecord(identity bcgo.Identity, record *bcgo.Record) error {
+	return identity.Verify(cryptogo.Hash(record.Payload), record.Signature, record.SignatureAlgorithm)
+}
+
+// Audit walks the given channel from head to genesis, checking the integrity of every block and record.
+// Signatures are verified with identities parsed by the given codecs, and progress is reported as the fraction of the chain length visited.
+func Audit(codecs []storage.KeyCodec, cache bcgo.Cache, network bcgo.Network, name string, threshold uint64, progress func(float64)) (*AuditReport, error) {
+	head, err := bcgo.LoadHead(name, cache, network)
+	if err != nil {
+		return nil, err
+	}
+	report := &AuditReport{
+		Channel:   name,
+		Head:      head.BlockHash,
+		Threshold: threshold,
+	}
+	identities := make(map[string]bcgo.Identity)
+	identity := func(alias string) (bcgo.Identity, error) {
+		if i, ok := identities[alias]; ok {
+			return i, nil
+		}
+		i, err := IdentityForAlias(codecs, cache, network, alias)
+		if err != nil {
+			return nil, err
+		}
+		identities[alias] = i
+		return i, nil
+	}
+	var (
+		length     uint64
+		nextLength uint64
+		timestamp  uint64
+		next       storage.BlockURI
+	)
+	hash := head.BlockHash
+	for len(hash) > 0 {
+		uri := storage.NewBlockURI(name, hash)
+		block, err := bcgo.LoadBlock(name, cache, network, hash)
+		if err != nil {
+			report.add(ANOMALY_LINKAGE, uri, "Block could not be loaded: %s", err)
+			return report, nil
+		}
+		report.Blocks++
+		if length == 0 {
+			length = block.Length
+		}
+		if h, err := cryptogo.HashProtobuf(block); err != nil {
+			return nil, err
+		} else if !bytes.Equal(h, hash) {
+			report.add(ANOMALY_BLOCK_HASH, uri, "Content hashes to %s", storage.NewBlockURI(name, h))
+		}
+		if ones := bcgo.Ones(hash); ones < threshold {
+			report.add(ANOMALY_THRESHOLD, uri, "Hash has %d ones, below threshold %d", ones, threshold)
+		}
+		if block.ChannelName != name {
+			report.add(ANOMALY_LINKAGE, uri, "Block belongs to channel %s", block.ChannelName)
+		}
+		if next != nil {
+			if timestamp < block.Timestamp {
+				report.add(ANOMALY_TIMESTAMP, next, "Block is older than its previous block %s", uri)
+			}
+			// Lengths must decrease by one towards genesis
+			if block.Length+1 != nextLength {
+				report.add(ANOMALY_LINKAGE, next, "Length %d does not follow previous length %d", nextLength, block.Length)
+			}
+		}
+		if block.Length > 1 && len(block.Previous) == 0 {
+			report.add(ANOMALY_LINKAGE, uri, "Block of length %d has no previous block", block.Length)
+		} else if block.Length <= 1 && len(block.Previous) > 0 {
+			report.add(ANOMALY_LINKAGE, uri, "Genesis block has a previous block")
+		}
+		for _, entry := range block.Entry {
+			report.Records++
+			record := entry.Record
+			recordURI := storage.NewRecordURI(name, hash, entry.RecordHash)
+			if h, err := cryptogo.HashProtobuf(record); err != nil {
+				return nil, err
+			} else if !bytes.Equal(h, entry.RecordHash) {
+				report.add(ANOMALY_RECORD_HASH, recordURI, "Content hashes to %s", storage.NewRecordURI(name, hash, h))
+			}
+			if record.Timestamp > block.Timestamp {
+				report.add(ANOMALY_TIMESTAMP, recordURI, "Record is newer than its block")
+			}
+			i, err := identity(record.Creator)
+			if err != nil {
+				report.add(ANOMALY_UNKNOWN_IDENTITY, recordURI, "Creator %s could not be resolved: %s", record.Creator, err)
+				continue
+			}
+			if err := VerifyRecord(i, record); err != nil {
+				report.add(ANOMALY_SIGNATURE, recordURI, "Signature does not match key of %s: %s", record.Creator, err)
+			}
+		}
+		if p := progress; p != nil && length > 0 {
+			p(float64(report.Blocks) / float64(length))
+		}
+		next = uri
+		nextLength = block.Length
+		timestamp = block.Timestamp
+		hash = block.Previous
+	}
+	report.Complete = true
+	return report, nil
+}
+
+// AuditView lists the anomalies of an audit report, each linking to the offending block or record.
+type AuditView struct {
+	widget.Form
+	ui        UI
+	client    bcclientgo.BCClient
+	summary   *widget.Label
+	anomalies *PageView
+}
+
+func NewAuditView(ui UI, client bcclientgo.BCClient) *AuditView {
+	v := &AuditView{
+		ui:     ui,
+		client: client,
+		summary: &widget.Label{
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+			Wrapping: fyne.TextWrapBreak,
+		},
+		anomalies: NewPageView(),
+	}
+	v.ExtendBaseWidget(v)
+	v.summary.ExtendBaseWidget(v.summary)
+	v.Append("Summary", v.summary)
+	v.Append("Anomalies", v.anomalies)
+	return v
+}
+
+func (v *AuditView) SetReport(report *AuditReport) {
+	status := "Complete"
+	if !report.Complete {
+		status = "Incomplete"
+	}
+	v.summary.SetText(fmt.Sprintf("%s\n%d blocks, %d records\n%d anomalies", status, report.Blocks, report.Records, len(report.Anomalies)))
+	v.anomalies.SetItems(len(report.Anomalies), func(i int) fyne.CanvasObject {
+		a := report.Anomalies[i]
+		link := &Link{
+			Hyperlink: widget.Hyperlink{
+				Text: a.URI.String(),
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+				Wrapping: fyne.TextWrapBreak,
+			},
+			OnTapped: func() {
+				v.ui.ShowURI(v.client, a.URI)
+			},
+		}
+		link.ExtendBaseWidget(link)
+		return container.NewVBox(
+			widget.NewLabelWithStyle(a.Kind, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+			&widget.Label{
+				Text:     a.Message,
+				Wrapping: fyne.TextWrapWord,
+			},
+			link,
+		)
+	})
+	v.Refresh()
+}
+
+// ShowAudit audits the given channel with a progress dialog, then shows the report in the window with an option to export it.
+func ShowAudit(ui UI, client bcclientgo.BCClient, name string, threshold uint64, window fyne.Window) {
+	cache, err := client.Cache()
+	if err != nil {
+		ui.ShowError(err)
+		return
+	}
+	network, err := client.Network()
+	if err != nil {
+		ui.ShowError(err)
+		return
+	}
+
+	// Show Progress Dialog
+	progress := dialog.NewProgress("Auditing", "Auditing "+name, window)
+	progress.Show()
+
+	report, err := Audit(ui.KeyCodecs(), cache, network, name, threshold, progress.SetValue)
+
+	// Hide Progress Dialog
+	progress.Hide()
+
+	if err != nil {
+		ui.ShowError(err)
+		return
+	}
+
+	view := NewAuditView(ui, client)
+	view.SetReport(report)
+	export := widget.NewButton("Export", func() {
+		d := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			if writer == nil {
+				return
+			}
+			defer writer.Close()
+			if err := report.WriteText(writer); err != nil {
+				ui.ShowError(err)
+			}
+		}, window)
+		d.SetFileName(name + "-audit.txt")
+		d.Show()
+	})
+	d := dialog.NewCustom("Audit "+name, "Close", container.NewBorder(nil, export, nil, nil, container.NewVScroll(view)), window)
+	d.Show()
+	d.Resize(WindowSize)
+}
+
+// ShowAuditOptions asks for the threshold the channel is expected to meet, then audits it.
+func ShowAuditOptions(ui UI, client bcclientgo.BCClient, name string, window fyne.Window) {
+	threshold := widget.NewSelect(ThresholdNames, nil)
+	threshold.SetSelected("G")
+	dialog.ShowForm("Audit "+name, "Audit", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Threshold", threshold),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		go ShowAudit(ui, client, name, Thresholds[threshold.Selected], window)
+	}, window)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcfynego/ui"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/account"
+	"aletheiaware.com/bcgo/cache"
+	"aletheiaware.com/bcgo/channel"
+	"aletheiaware.com/bcgo/node"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+)
+
+func makeAccount(t *testing.T, alias string) bcgo.Account {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return account.NewRSA(alias, key)
+}
+
+// writeRecord writes a record to the channel with bcgo.CreateRecord, then mines it.
+func writeRecord(t *testing.T, n bcgo.Node, c bcgo.Channel, payload string) {
+	t.Helper()
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), n.Account(), nil, nil, []byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bcgo.WriteRecord(c.Name(), n.Cache(), record); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := n.Mine(c, bcgo.THRESHOLD_Z, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// registerAlias mines the alias record of the node's account.
+func registerAlias(t *testing.T, n bcgo.Node) {
+	t.Helper()
+	aliases, err := n.OpenChannel(aliasgo.ALIAS, aliasgo.OpenAliasChannel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, _, err := aliasgo.CreateSignedAliasRecord(n.Account())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bcgo.WriteRecord(aliases.Name(), n.Cache(), record); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := n.Mine(aliases, bcgo.THRESHOLD_Z, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func anomalies(report *ui.AuditReport) map[string]int {
+	kinds := make(map[string]int)
+	for _, a := range report.Anomalies {
+		kinds[a.Kind]++
+	}
+	return kinds
+}
+
+func Test_Audit(t *testing.T) {
+	c := cache.NewMemory(10)
+	alice := node.New(makeAccount(t, "Alice"), c, nil)
+	registerAlias(t, alice)
+	test := channel.New("Test")
+	writeRecord(t, alice, test, "Hello")
+	writeRecord(t, alice, test, "World")
+	codecs := []storage.KeyCodec{storage.NewRSAKeyCodec()}
+
+	t.Run("Valid", func(t *testing.T) {
+		report, err := ui.Audit(codecs, c, nil, "Test", bcgo.THRESHOLD_Z, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !report.Complete || report.Blocks != 2 || report.Records != 2 {
+			t.Fatalf("Incorrect report; got %+v", report)
+		}
+		if len(report.Anomalies) != 0 {
+			t.Fatalf("Expected no anomalies, got %v", anomalies(report))
+		}
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		report, err := ui.Audit(codecs, c, nil, "Test", 512, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := anomalies(report); got[ui.ANOMALY_THRESHOLD] != 2 {
+			t.Fatalf("Expected 2 threshold anomalies, got %v", got)
+		}
+	})
+
+	t.Run("UnknownIdentity", func(t *testing.T) {
+		bob := node.New(makeAccount(t, "Bob"), c, nil)
+		other := channel.New("Other")
+		writeRecord(t, bob, other, "Hello")
+		report, err := ui.Audit(codecs, c, nil, "Other", bcgo.THRESHOLD_Z, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := anomalies(report); len(got) != 1 || got[ui.ANOMALY_UNKNOWN_IDENTITY] != 1 {
+			t.Fatalf("Expected 1 unknown identity anomaly, got %v", got)
+		}
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		block, err := c.Block(test.Head())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block.Entry[0].Record.Payload = []byte("Tampered")
+		report, err := ui.Audit(codecs, c, nil, "Test", bcgo.THRESHOLD_Z, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := anomalies(report)
+		for _, kind := range []string{ui.ANOMALY_BLOCK_HASH, ui.ANOMALY_RECORD_HASH, ui.ANOMALY_SIGNATURE} {
+			if got[kind] != 1 {
+				t.Fatalf("Expected 1 %s anomaly, got %v", kind, got)
+			}
+		}
+	})
+}
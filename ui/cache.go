@@ -17,12 +17,41 @@
 package ui
 
 import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
 	"aletheiaware.com/bcgo"
 	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/widget"
+	"log"
 )
 
+// CachedHeads returns every cached channel mapped to its cached head, without contacting the network.
+func CachedHeads(client bcclientgo.BCClient, cache bcgo.Cache) (map[string][]byte, error) {
+	rootDir, err := client.Root()
+	if err != nil {
+		return nil, err
+	}
+	cacheDir, err := bcgo.CacheDirectory(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := storage.CachedChannels(cacheDir)
+	if err != nil {
+		return nil, err
+	}
+	heads := make(map[string][]byte, len(channels))
+	for _, name := range channels {
+		head, err := cache.Head(name)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		heads[name] = head.BlockHash
+	}
+	return heads, nil
+}
+
 type CacheView struct {
 	widget.Label
 	updater func() bcgo.Cache
@@ -0,0 +1,505 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/cryptogo"
+	"bytes"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	fynestorage "fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+// Compressors maps the name of each supported compression algorithm to its implementation.
+var Compressors = map[string]func([]byte) ([]byte, error){
+	cryptogo.CompressionAlgorithm_UNKNOWN_COMPRESSION.String(): func(data []byte) ([]byte, error) {
+		return data, nil
+	},
+}
+
+func init() {
+	for _, a := range storage.Compressions {
+		algorithm := a
+		Compressors[algorithm] = func(data []byte) ([]byte, error) {
+			return storage.Compress(algorithm, data)
+		}
+	}
+}
+
+// Composer holds the form used to create a new record.
+type Composer struct {
+	Payload       *widget.Entry
+	File          *widget.Entry
+	Compression   *widget.Select
+	Alias         *widget.SelectEntry
+	Reference     *widget.Entry
+	MetaKey       *widget.Entry
+	MetaValue     *widget.Entry
+	ui            UI
+	client        bcclientgo.BCClient
+	window        fyne.Window
+	aliases       []string
+	access        []bcgo.Identity
+	accessList    *fyne.Container
+	references    []*bcgo.Reference
+	referenceList *fyne.Container
+	meta          map[string]string
+	metaList      *fyne.Container
+}
+
+func NewComposer(ui UI, client bcclientgo.BCClient, window fyne.Window) *Composer {
+	c := &Composer{
+		Payload:       widget.NewMultiLineEntry(),
+		File:          widget.NewEntry(),
+		Alias:         widget.NewSelectEntry(nil),
+		Reference:     widget.NewEntry(),
+		MetaKey:       widget.NewEntry(),
+		MetaValue:     widget.NewEntry(),
+		ui:            ui,
+		client:        client,
+		window:        window,
+		accessList:    container.NewVBox(),
+		referenceList: container.NewVBox(),
+		meta:          make(map[string]string),
+		metaList:      container.NewVBox(),
+	}
+	c.Payload.PlaceHolder = "Payload"
+	c.Payload.Wrapping = fyne.TextWrapWord
+	c.File.PlaceHolder = "File"
+	c.File.Wrapping = fyne.TextWrapOff
+	var compressions []string
+	for name := range Compressors {
+		compressions = append(compressions, name)
+	}
+	sort.Strings(compressions)
+	c.Compression = widget.NewSelect(compressions, nil)
+	c.Compression.SetSelected(cryptogo.CompressionAlgorithm_UNKNOWN_COMPRESSION.String())
+	c.Alias.PlaceHolder = "Alias"
+	c.Alias.Wrapping = fyne.TextWrapOff
+	c.Alias.OnChanged = func(s string) {
+		// Autocomplete from the alias directory
+		var options []string
+		for _, a := range c.aliases {
+			if s != "" && strings.HasPrefix(strings.ToLower(a), strings.ToLower(s)) {
+				options = append(options, a)
+			}
+		}
+		c.Alias.SetOptions(options)
+	}
+	c.Alias.OnSubmitted = func(string) {
+		go c.addAccess()
+	}
+	c.Reference.PlaceHolder = "bc:channel/block/record"
+	c.Reference.Wrapping = fyne.TextWrapOff
+	c.Reference.OnSubmitted = func(string) {
+		go c.addReference()
+	}
+	c.MetaKey.PlaceHolder = "Key"
+	c.MetaKey.Wrapping = fyne.TextWrapOff
+	c.MetaValue.PlaceHolder = "Value"
+	c.MetaValue.Wrapping = fyne.TextWrapOff
+	c.MetaValue.OnSubmitted = func(string) {
+		c.addMeta()
+	}
+	return c
+}
+
+func (c *Composer) CanvasObject() fyne.CanvasObject {
+	return widget.NewForm(
+		widget.NewFormItem("Payload", c.Payload),
+		widget.NewFormItem("File", container.NewBorder(nil, nil, nil, NewFilePicker(c.window, c.File), c.File)),
+		widget.NewFormItem("Compression", c.Compression),
+		widget.NewFormItem("Access", c.AccessObject()),
+		widget.NewFormItem("References", container.NewVBox(
+			c.referenceList,
+			container.NewBorder(nil, nil, nil, container.NewHBox(
+				widget.NewButtonWithIcon("", theme.ContentAddIcon(), func() {
+					go c.addReference()
+				}),
+				widget.NewButtonWithIcon("", theme.SearchIcon(), func() {
+					go c.PickReference()
+				}),
+			), c.Reference),
+		)),
+		widget.NewFormItem("Metadata", container.NewVBox(
+			c.metaList,
+			container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentAddIcon(), c.addMeta), container.NewGridWithColumns(2, c.MetaKey, c.MetaValue)),
+		)),
+	)
+}
+
+// AccessObject returns the list of aliases granted access, and the entry used to add more.
+func (c *Composer) AccessObject() fyne.CanvasObject {
+	return container.NewVBox(
+		c.accessList,
+		container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentAddIcon(), func() {
+			go c.addAccess()
+		}), c.Alias),
+	)
+}
+
+// LoadAliases fills the alias autocomplete options from the alias directory.
+func (c *Composer) LoadAliases() {
+	cache, err := c.client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	network, err := c.client.Network()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	entries, err := LoadAliases(cache, network)
+	if err != nil {
+		log.Println(err)
+	}
+	var aliases []string
+	for _, e := range entries {
+		aliases = append(aliases, e.Alias)
+	}
+	sort.Strings(aliases)
+	c.aliases = aliases
+}
+
+func (c *Composer) addAccess() {
+	alias := strings.TrimSpace(c.Alias.Text)
+	if alias == "" {
+		return
+	}
+	for _, i := range c.access {
+		if i.Alias() == alias {
+			return
+		}
+	}
+	cache, err := c.client.Cache()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	network, err := c.client.Network()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	// Lookup the public key of the alias
+	identity, err := IdentityForAlias(c.ui.KeyCodecs(), cache, network, alias)
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	_, key, err := identity.PublicKey()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	c.access = append(c.access, identity)
+	var row *fyne.Container
+	row = container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentRemoveIcon(), func() {
+		for i, a := range c.access {
+			if a == identity {
+				c.access = append(c.access[:i], c.access[i+1:]...)
+				break
+			}
+		}
+		c.accessList.Remove(row)
+	}), container.NewVBox(NewAliasLabel(alias), &widget.Label{
+		Text:      Fingerprint(key),
+		TextStyle: fyne.TextStyle{Monospace: true},
+		Wrapping:  fyne.TextWrapBreak,
+	}))
+	c.accessList.Add(row)
+	c.Alias.SetText("")
+}
+
+func (c *Composer) addReference() {
+	s := strings.TrimSpace(c.Reference.Text)
+	if s == "" {
+		return
+	}
+	if !strings.HasPrefix(s, storage.BC_SCHEME_PREFIX) {
+		s = storage.BC_SCHEME_PREFIX + s
+	}
+	u, err := fynestorage.ParseURI(s)
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	uri, ok := u.(storage.RecordURI)
+	if !ok {
+		c.ui.ShowError(fmt.Errorf("Not a record: %s", s))
+		return
+	}
+	c.addReferenceURI(uri)
+	c.Reference.SetText("")
+}
+
+func (c *Composer) addReferenceURI(uri storage.RecordURI) {
+	for _, r := range c.references {
+		if r.ChannelName == uri.Channel() && bytes.Equal(r.RecordHash, uri.RecordHash()) {
+			return
+		}
+	}
+	cache, err := c.client.Cache()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	network, err := c.client.Network()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	// Ensure the record exists
+	record, err := loadRecord(cache, network, uri)
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	reference := &bcgo.Reference{
+		Timestamp:   record.Timestamp,
+		ChannelName: uri.Channel(),
+		BlockHash:   uri.BlockHash(),
+		RecordHash:  uri.RecordHash(),
+	}
+	c.references = append(c.references, reference)
+	var row *fyne.Container
+	row = container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentRemoveIcon(), func() {
+		for i, r := range c.references {
+			if r == reference {
+				c.references = append(c.references[:i], c.references[i+1:]...)
+				break
+			}
+		}
+		c.referenceList.Remove(row)
+	}), &widget.Label{
+		Text:      uri.String(),
+		TextStyle: fyne.TextStyle{Monospace: true},
+		Wrapping:  fyne.TextWrapBreak,
+	})
+	c.referenceList.Add(row)
+}
+
+// PickReference shows the records of the cached channels, and adds the one tapped as a reference.
+func (c *Composer) PickReference() {
+	cache, err := c.client.Cache()
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	heads, err := CachedHeads(c.client, cache)
+	if err != nil {
+		c.ui.ShowError(err)
+		return
+	}
+	var channels []string
+	for name := range heads {
+		channels = append(channels, name)
+	}
+	sort.Strings(channels)
+	records := NewPageView()
+	var d dialog.Dialog
+	channel := widget.NewSelect(channels, func(name string) {
+		type item struct {
+			uri       storage.RecordURI
+			creator   string
+			timestamp uint64
+		}
+		var items []*item
+		if err := bcgo.Iterate(name, heads[name], nil, cache, nil, func(hash []byte, block *bcgo.Block) error {
+			for _, entry := range block.Entry {
+				items = append(items, &item{
+					uri:       storage.NewRecordURI(name, hash, entry.RecordHash),
+					creator:   entry.Record.Creator,
+					timestamp: entry.Record.Timestamp,
+				})
+			}
+			return nil
+		}); err != nil {
+			// Only part of the channel is cached
+			log.Println(err)
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].timestamp > items[j].timestamp
+		})
+		records.SetItems(len(items), func(i int) fyne.CanvasObject {
+			item := items[i]
+			return widget.NewButton(item.creator+" "+bcgo.TimestampToString(item.timestamp), func() {
+				d.Hide()
+				go c.addReferenceURI(item.uri)
+			})
+		})
+	})
+	channel.PlaceHolder = "Channel"
+	d = dialog.NewCustom("Pick Reference", "Cancel", container.NewBorder(channel, nil, nil, nil, container.NewVScroll(records)), c.window)
+	d.Show()
+	d.Resize(DialogSize)
+}
+
+func (c *Composer) addMeta() {
+	key := strings.TrimSpace(c.MetaKey.Text)
+	if key == "" {
+		return
+	}
+	c.meta[key] = c.MetaValue.Text
+	c.updateMeta()
+	c.MetaKey.SetText("")
+	c.MetaValue.SetText("")
+	c.window.Canvas().Focus(c.MetaKey)
+}
+
+func (c *Composer) updateMeta() {
+	var keys []string
+	for k := range c.meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var rows []fyne.CanvasObject
+	for _, k := range keys {
+		key := k
+		rows = append(rows, container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentRemoveIcon(), func() {
+			delete(c.meta, key)
+			c.updateMeta()
+		}), container.NewGridWithColumns(2,
+			&widget.Label{
+				Text: key,
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+			},
+			&widget.Label{
+				Text: c.meta[key],
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+			},
+		)))
+	}
+	c.metaList.Objects = rows
+	c.metaList.Refresh()
+}
+
+// ReadPayload returns the contents of the chosen file, or the entered text if no file was chosen.
+func (c *Composer) ReadPayload() ([]byte, error) {
+	if c.File.Text == "" {
+		return []byte(c.Payload.Text), nil
+	}
+	u, err := fynestorage.ParseURI(c.File.Text)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := fynestorage.Reader(u)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+// CreateRecord returns a new record signed by the given account, without writing it to any channel.
+func (c *Composer) CreateRecord(account bcgo.Account) (*bcgo.Record, error) {
+	payload, err := c.ReadPayload()
+	if err != nil {
+		return nil, err
+	}
+	compression := c.Compression.Selected
+	compressor, ok := Compressors[compression]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported compression: %s", compression)
+	}
+	payload, err = compressor(payload)
+	if err != nil {
+		return nil, err
+	}
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), account, c.access, c.references, payload)
+	if err != nil {
+		return nil, err
+	}
+	meta := make(map[string]string, len(c.meta)+1)
+	for k, v := range c.meta {
+		meta[k] = v
+	}
+	if value, ok := cryptogo.CompressionAlgorithm_value[compression]; ok {
+		record.CompressionAlgorithm = cryptogo.CompressionAlgorithm(value)
+	} else {
+		meta[storage.META_COMPRESSION] = compression
+	}
+	if len(meta) > 0 {
+		record.Meta = meta
+	}
+	return record, nil
+}
+
+// ShowComposer shows a dialog to compose a record, preview it once signed, and write it to the given channel.
+func ShowComposer(ui UI, client bcclientgo.BCClient, channel string, window fyne.Window) {
+	composer := NewComposer(ui, client, window)
+	go composer.LoadAliases()
+	d := dialog.NewCustomConfirm("Compose Record", "Preview", "Cancel", container.NewVScroll(composer.CanvasObject()), func(ok bool) {
+		if !ok {
+			return
+		}
+		go func() {
+			node, err := ui.Node(client)
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			record, err := composer.CreateRecord(node.Account())
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			hash, err := cryptogo.HashProtobuf(record)
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			preview := NewRecordView(ui, client)
+			preview.SetHash(hash)
+			preview.SetRecord(record)
+			p := dialog.NewCustomConfirm("Preview Record", "Write", "Cancel", container.NewVScroll(preview), func(ok bool) {
+				if !ok {
+					return
+				}
+				reference, err := bcgo.WriteRecord(channel, node.Cache(), record)
+				if err != nil {
+					ui.ShowError(err)
+					return
+				}
+				if !bytes.Equal(reference.RecordHash, hash) {
+					log.Println("Written record hash differs from preview")
+				}
+				dialog.ShowInformation("Record Written", fmt.Sprintf("Record written to %s, mine the channel to add it to a block", channel), window)
+			}, window)
+			p.Show()
+			p.Resize(WindowSize)
+		}()
+	}, window)
+	d.Show()
+	d.Resize(WindowSize)
+}
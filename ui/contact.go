@@ -0,0 +1,129 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"log"
+)
+
+// NewKeyChangedWarning returns a prominent warning that the key of an alias no longer matches the pinned key.
+func NewKeyChangedWarning(err storage.ErrKeyChanged) fyne.CanvasObject {
+	return container.NewVBox(
+		container.NewPadded(&canvas.Text{
+			Alignment: fyne.TextAlignCenter,
+			Color:     theme.PrimaryColorNamed(theme.ColorRed),
+			Text:      "KEY CHANGED",
+			TextSize:  theme.TextSize(),
+			TextStyle: fyne.TextStyle{
+				Bold:      true,
+				Monospace: true,
+			},
+		}),
+		&widget.Label{
+			Text:     err.Error(),
+			Wrapping: fyne.TextWrapWord,
+		},
+		widget.NewForm(
+			widget.NewFormItem("Pinned", &widget.Label{
+				Text:      FormatFingerprint(err.Pinned),
+				TextStyle: fyne.TextStyle{Monospace: true},
+				Wrapping:  fyne.TextWrapBreak,
+			}),
+			widget.NewFormItem("Current", &widget.Label{
+				Text:      FormatFingerprint(err.Current),
+				TextStyle: fyne.TextStyle{Monospace: true},
+				Wrapping:  fyne.TextWrapBreak,
+			}),
+		),
+	)
+}
+
+// pinnedKeyChecker compares the current keys of aliases with those pinned in the contact book.
+// The key history of each alias is resolved once, so a view can check the same alias many times cheaply.
+type pinnedKeyChecker struct {
+	ui        UI
+	client    bcclientgo.BCClient
+	cache     bcgo.Cache
+	network   bcgo.Network
+	histories map[string][]*storage.KeyVersion
+}
+
+func newPinnedKeyChecker(ui UI, client bcclientgo.BCClient) *pinnedKeyChecker {
+	return &pinnedKeyChecker{
+		ui:        ui,
+		client:    client,
+		histories: make(map[string][]*storage.KeyVersion),
+	}
+}
+
+// Check resolves the current key of the given alias, pinning it if the alias has never been seen,
+// and returns a warning if the key has changed, or nil.
+// A key reached from the pinned key by signed rotations is pinned in its place without a warning.
+func (c *pinnedKeyChecker) Check(alias string) fyne.CanvasObject {
+	contacts, err := c.ui.ContactBook(c.client)
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	if c.cache == nil {
+		cache, err := c.client.Cache()
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		network, err := c.client.Network()
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		c.cache = cache
+		c.network = network
+	}
+	history, ok := c.histories[alias]
+	if !ok {
+		h, err := storage.KeyHistory(c.ui.KeyCodecs(), c.cache, c.network, alias)
+		if err != nil {
+			log.Println(err)
+			return nil
+		}
+		history = h
+		c.histories[alias] = history
+	}
+	current := history[len(history)-1].PublicKey
+	switch err := contacts.Check(alias, current).(type) {
+	case nil:
+	case storage.ErrKeyChanged:
+		if storage.FollowsRotation(err.Pinned, history) {
+			if err := contacts.Pin(alias, current); err != nil {
+				log.Println(err)
+			}
+			return nil
+		}
+		return NewKeyChangedWarning(err)
+	default:
+		log.Println(err)
+	}
+	return nil
+}
@@ -20,3 +20,4 @@ set -x
 fyne bundle -name AW -package data aw.svg > icon.go
 fyne bundle -append -name Logo -package data bc.svg >> icon.go
 fyne bundle -append -name AccountIcon -package data account.svg >> icon.go
+fyne bundle -append -name CommonPasswords -package data passwords.txt >> icon.go
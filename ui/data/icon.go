@@ -20,3 +20,8 @@ var AccountIcon = &fyne.StaticResource{
 	StaticContent: []byte(
 		"<!DOCTYPE svg PUBLIC \"-//W3C//DTD SVG 1.1//EN\" \"http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd\">\n<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"24px\" height=\"24px\" viewBox=\"0 0 24 24\">\n    <path stroke=\"none\" d=\"M12 2C6.48 2 2 6.48 2 12s4.48 10 10 10 10-4.48 10-10S17.52 2 12 2zm0 3c1.66 0 3 1.34 3 3s-1.34 3-3 3-3-1.34-3-3 1.34-3 3-3zm0 14.2c-2.5 0-4.71-1.28-6-3.22.03-1.99 4-3.08 6-3.08 1.99 0 5.97 1.09 6 3.08-1.29 1.94-3.5 3.22-6 3.22z\"/>\n</svg>\n"),
 }
+var CommonPasswords = &fyne.StaticResource{
+	StaticName: "passwords.txt",
+	StaticContent: []byte(
+		"# Common passwords, most frequent first, one per line.\n# Extends the top passwords with the password list of zxcvbn,\n# Copyright (c) 2012-2016 Dan Wheeler and Dropbox, Inc., MIT License,\n# as distributed with zxcvbn-go, Copyright (c) Nathan Button, MIT License.\n123456\npassword\n12345678\nqwerty\n123456789\n12345\n1234\n111111\n1234567\ndragon\n123123\nbaseball\nabc123\nfootball\nmonkey\nletmein\n696969\nshadow\nmaster\n666666\nqwertyuiop\n123321\nmustang\n1234567890\nmichael\n654321\nsuperman\n1qaz2wsx\n7777777\n121212\n000000\nqazwsx\n123qwe\nkiller\ntrustno1\njordan\njennifer\nzxcvbnm\nasdfgh\nhunter\nbuster\nsoccer\nharley\nbatman\nandrew\ntigger\nsunshine\niloveyou\n2000\ncharlie\nrobert\nthomas\nhockey\nranger\ndaniel\nstarwars\nklaster\n112233\ngeorge\ncomputer\nmichelle\njessica\npepper\n1111\nzxcvbn\n555555\n11111111\n131313\nfreedom\n777777\npass\nmaggie\n159753\naaaaaa\nginger\nprincess\njoshua\ncheese\namanda\nsummer\nlove\nashley\nnicole\nchelsea\nbiteme\nmatthew\naccess\nyankees\n987654321\ndallas\naustin\nthunder\ntaylor\nmatrix\nmobilemail\nmom\nmonitor\nmonitoring\nmontana\nmoon\nmoscow\nwelcome\nwelcome1\nadmin\nadministrator\npassw0rd\npassword1\npassword12\npassword123\npassword1234\npassword12345\nqwerty123\nqwerty1234\nqwertyuiop123\n1q2w3e4r\n1q2w3e4r5t\n1q2w3e4r5t6y\nq1w2e3r4t5y6\nasdfghjkl\nasdfghjkl123\nzaq12wsx\nzaq1zaq1\nabcdef\nabcdefgh\nabcdefghijkl\nabc123456789\n123456789012\n1234567890123\n12345678910\n123123123123\n0987654321\niloveyou123\niloveyou1234\nsunshine123\nprincess123\nfootball123\nbaseball123\nsuperman123\nletmein123\nwelcome123\nwelcome1234\nmonkey123\ndragon123\nmaster123\nchangeme\nchangeme123\nsecret\nsecret123\ntrustno1234\nwhatever\nwhatever123\nhello123\nhelloworld\nhelloworld123\nloveyou\nlovely\nflower\ncookie\nbanana\norange\npurple\ninternet\nsamsung\ngoogle\nfacebook\nlinkedin\ntwitter\nblockchain\nbitcoin\nethereum\naletheia\naletheiaware\ncorrecthorsebatterystaple\npussy\nfuckme\nfuckyou\nfuck\ntest\nasshole\n6969\nsilver\nhello\nsexy\nhammer\ncorvette\nfucker\nmerlin\ngolfer\ndiamond\nyellow\nbigdog\nsparky\ncowboy\ncamaro\nfalcon\nguitar\nscooter\nphoenix\ntigers\nporsche\nmickey\nmaverick\nnascar\npeanut\nmoney\nhorny\nsamantha\npanties\nsteelers\nsnoopy\nboomer\niceman\nsmokey\ngateway\ndakota\ncowboys\neagles\nchicken\ndick\nblack\nferrari\nknight\nhardcore\ncompaq\ncoffee\nbooboo\nbitch\nbulldog\nxxxxxx\nplayer\nncc1701\nwizard\nscooby\njunior\nbigdick\nbrandy\ntennis\nblowjob\nmonster\nspider\nlakers\nrabbit\nenter\nmercedes\nfender\nyamaha\ndiablo\nboston\ntiger\nmarine\nchicago\nrangers\ngandalf\nwinter\nbigtits\nbarney\nraiders\nporn\nbadboy\nblowme\nspanky\nbigdaddy\nchester\nlondon\nmidnight\nblue\nfishing\nhannah\nslayer\nsexsex\nredsox\nthx1138\nasdf\nmarlboro\npanther\narsenal\nmother\njasper\nwinner\ngolden\nbutthead\nviking\niwantu\nangels\nprince\ncameron\ngirls\nmadison\nhooters\nstartrek\ncaptain\nmaddog\njasmine\nbutter\nbooger\ngolf\nrocket\ntheman\nliverpoo\nforever\nmuffin\nturtle\nsophie\nredskins\ntoyota\nsierra\nwinston\ngiants\npackers\nnewyork\ncasper\nbubba\nlovers\nmountain\nunited\ndriver\nhelpme\nfucking\npookie\nlucky\nmaxwell\n8675309\nbear\nsuckit\ngators\n5150\n222222\nshithead\nfuckoff\njaguar\nhotdog\ntits\ngemini\nlover\nxxxxxxxx\ncanada\nflorida\n88888888\nrosebud\nmetallic\ndoctor\ntrouble\nsuccess\nstupid\ntomcat\nwarrior\npeaches\napples\nfish\nqwertyui\nmagic\nbuddy\ndolphins\nrainbow\ngunner\n987654\nfreddy\nalexis\nbraves\ncock\n2112\n1212\ncocacola\nxavier\ndolphin\ntesting\nbond007\nmember\nvoodoo\n7777\nsamson\napollo\nfire\ntester\nbeavis\nvoyager\nporno\nrush2112\nbeer\napple\nscorpio\nskippy\nsydney\nred123\npower\nbeaver\nstar\njackass\nflyers\nboobs\n232323\nzzzzzz\nscorpion\ndoggie\nlegend\nou812\nyankee\nblazer\nrunner\nbirdie\nbitches\ntopgun\nasdfasdf\nheaven\nviper\nanimal\n2222\nbigboy\n4444\nprivate\ngodzilla\nlifehack\nphantom\nrock\naugust\nsammy\ncool\nplatinum\njake\nbronco\nheka6w2\ncopper\ncumshot\ngarfield\nwillow\ncunt\nslut\n69696969\nkitten\nsuper\njordan23\neagle1\nshelby\namerica\n11111\nfree\nchevy\nbullshit\nbroncos\nhorney\nsurfer\nnissan\n999999\nsaturn\nairborne\nelephant\nshit\naction\nadidas\nqwert\n1313\nexplorer\npolice\nchristin\ndecember\nwolf\nsweet\ntherock\nonline\ndickhead\nbrooklyn\ncricket\nracing\npenis\n0000\nteens\nredwings\ndreams\nmichigan\nhentai\nmagnum\n87654321\ndonkey\ntrinity\ndigital\n333333\ncartman\nguinness\n123abc\nspeedy\nbuffalo\nkitty\npimpin\neagle\neinstein\nnirvana\nvampire\nxxxx\nplayboy\npumpkin\nsnowball\ntest123\nsucker\nmexico\nbeatles\nfantasy\nceltic\ncherry\ncassie\n888888\nsniper\ngenesis\nhotrod\nreddog\nalexande\ncollege\njester\nbigcock\nlasvegas\nslipknot\n3333\ndeath\n1q2w3e\neclipse\ndrummer\nmusic\naaaa\ncarolina\ncolorado\ncreative\nhello1\ngoober\nfriday\nbollocks\nscotty\nbubbles\nhawaii\nfluffy\nhorses\nthumper\n5555\npussies\ndarkness\nasdfghjk\nboobies\nbuddha\nsandman\nnaughty\nhonda\nazerty\n6666\nshorty\nmoney1\nbeach\nloveme\n4321\nsimple\npoohbear\n444444\nbadass\ndestiny\nvikings\nlizard\nassman\nnintendo\nnovember\nxxxxx\noctober\nleather\nbastard\n101010\nextreme\npussy1\nlacrosse\nhotmail\nspooky\namateur\nalaska\nbadger\nparadise\nmaryjane\npoop\nmozart\nvideo\nvagina\nspitfire\ncherokee\ncougar\n420420\nhorse\nenigma\nraider\nbrazil\nblonde\n55555\ndude\ndrowssap\nbooty\nsnickers\nnipples\ndiesel\nrocks\neminem\nwestside\nsuzuki\npassion\nhummer\nladies\nalpha\nsuckme\n147147\npirate\nsemperfi\njupiter\nredrum\nfreeuser\nwanker\nstinky\nducati\nparis\nbabygirl\nwindows\nspirit\npantera\nmonday\npatches\nbrutus\nsmooth\npenguin\nmarley\nforest\ncream\n212121\nflash\nmaximus\nnipple\nvision\npokemon\nchampion\nfireman\nindian\nsoftball\npicard\nsystem\ncobra\nenjoy\nlucky1\nboogie\nmarines\nsecurity\ndirty\nwildcats\npimp\ndancer\nhardon\nfucked\nabcd1234\nabcdefg\nironman\nwolverin\nfreepass\nbigred\nsquirt\njustice\nhobbes\npearljam\nmercury\ndomino\n9999\nrascal\nhitman\nmistress\nbbbbbb\npeekaboo\nnaked\nbudlight\nelectric\nsluts\nstargate\nsaints\nbondage\nbigman\nzombie\nswimming\nduke\nqwerty1\nbabes\nscotland\ndisney\nrooster\nmookie\nswordfis\nhunting\nblink182\n8888\nbubba1\nwhore\ngeneral\npassport\naaaaaaaa\nerotic\nliberty\narizona\nabcd\nnewport\nskipper\nrolltide\nballs\nhappy1\ngalore\nchrist\nweasel\n242424\nwombat\ndigger\nclassic\nbulldogs\npoopoo\naccord\npopcorn\nturkey\nbunny\nmouse\n007007\ntitanic\nliverpool\ndreamer\neverton\nchevelle\npsycho\nnemesis\npontiac\nconnor\neatme\nlickme\ncumming\nireland\nspiderma\npatriots\ngoblue\ndevils\nempire\nasdfg\ncardinal\nshaggy\nfroggy\nqwer\nkawasaki\nkodiak\nphpbb\n54321\nchopper\nhooker\nwhynot\nlesbian\nsnake\nteen\nncc1701d\nqqqqqq\nairplane\nbritney\navalon\nsugar\nsublime\nwildcat\nraven\nscarface\nelizabet\n123654\ntrucks\nwolfpack\npervert\nredhead\namerican\nbambam\nwoody\nshaved\nsnowman\ntiger1\nchicks\nraptor\n1969\nstingray\nshooter\nfrance\nstars\nmadmax\nsports\n789456\nsimpsons\nlights\nchronic\nhahaha\npackard\nhendrix\nservice\nspring\nsrinivas\nspike\n252525\nbigmac\nsuck\nsingle\npopeye\ntattoo\ntexas\nbullet\ntaurus\nsailor\nwolves\npanthers\njapan\nstrike\npussycat\nchris1\nloverboy\nberlin\nsticky\ntarheels\nrussia\nwolfgang\ntesttest\nmature\ncatch22\njuice\nmichael1\nnigger\nalpha1\ntrooper\nhawkeye\nfreaky\ndodgers\npakistan\nmachine\npyramid\nvegeta\nkatana\nmoose\ntinker\ncoyote\ninfinity\npepsi\nletmein1\nbang\nhercules\njames1\ntickle\noutlaw\nbrowns\nbillybob\npickle\ntest1\nsucks\npavilion\ncaesar\nprelude\ndarkside\nbowling\nwutang\nsunset\nalabama\ndanger\nzeppelin\npppppp\n2001\nping\ndarkstar\nmadonna\nqwe123\nbigone\ncasino\ncharlie1\nmmmmmm\nintegra\nwrangler\napache\ntweety\nqwerty12\nbobafett\ntransam\n2323\nseattle\nssssss\nopenup\npandora\npussys\ntrucker\nindigo\nstorm\nmalibu\nweed\nreview\nbabydoll\ndoggy\ndilbert\npegasus\njoker\ncatfish\nflipper\nfuckit\ndetroit\ncheyenne\nbruins\nsmoke\nmarino\nfetish\nxfiles\nstinger\npizza\nbabe\nstealth\nmanutd\ngundam\ncessna\nlonghorn\npresario\nmnbvcxz\nwicked\nmustang1\nvictory\n21122112\nawesome\nathena\nq1w2e3r4\nholiday\nknicks\nredneck\n12341234\ngizmo\nscully\ndragon1\ndevildog\ntriumph\nbluebird\nshotgun\npeewee\nangel1\nmetallica\nmadman\nimpala\nlennon\nomega\naccess14\nenterpri\nsearch\nsmitty\nblizzard\nunicorn\ntight\nasdf1234\ntrigger\ntruck\nbeauty\nthailand\ncadillac\ncastle\nbobcat\nbuddy1\nsunny\nstones\nasian\nbutt\nhellfire\nhotsex\nindiana\npanzer\nlonewolf\ntrumpet\ncolors\nblaster\n12121212\nfireball\nprecious\njungle\natlanta\ngold\ncorona\npolaris\ntimber\ntheone\nballer\nchipper\nskyline\ndragons\ndogs\nlicker\nengineer\nkong\npencil\nbasketba\nhornet\nbarbie\nwetpussy\nindians\nredman\nfoobar\ntravel\nmorpheus\ntarget\n141414\nhotstuff\nphotos\nrocky1\nfuck_inside\ndollar\nturbo\ndesign\nhottie\n202020\nblondes\n4128\nlestat\navatar\ngoforit\nrandom\nabgrtyu\njjjjjj\ncancer\nq1w2e3\nsmiley\nexpress\nvirgin\nzipper\nwrinkle1\nbabylon\nconsumer\nmonkey1\nserenity\nsamurai\n99999999\nbigboobs\nskeeter\njoejoe\nmaster1\naaaaa\nchocolat\nchristia\nstephani\ntang\n1234qwer\n98765432\nsexual\nmaxima\n77777777\nbuckeye\nhighland\nseminole\nreaper\nbassman\nnugget\nlucifer\nairforce\nnasty\nwarlock\n2121\ndodge\nchrissy\nburger\nsnatch\npink\ngang\nmaddie\nhuskers\npiglet\nphoto\ndodger\npaladin\nchubby\nbuckeyes\nhamlet\nbigfoot\nsunday\nmanson\ngoldfish\ngarden\ndeftones\nicecream\nblondie\nspartan\ncharger\nstormy\njuventus\ngalaxy\nescort\nzxcvb\nplanet\nblues\ndavid1\nncc1701e\n1966\n51505150\ncavalier\ngambit\nripper\noicu812\nnylons\naardvark\nwhiskey\nbing\nplastic\nanal\nbabylon5\nloser\nracecar\ninsane\nyankees1\nmememe\nhansolo\nchiefs\nfredfred\nfreak\nfrog\nsalmon\nconcrete\nzxcv\nshamrock\natlantis\nwordpass\nrommel\n1010\npredator\nmassive\ncats\nsammy1\nmister\nstud\nmarathon\nrubber\nding\ntrunks\ndesire\nmontreal\njustme\nfaster\nirish\n1999\njessica1\nalpine\ndiamonds\n00000\nswinger\nshan\nstallion\npitbull\nletmein2\nming\nshadow1\nclitoris\nfuckers\njackoff\nbluesky\nsundance\nrenegade\nhollywoo\n151515\nwolfman\nsoldier\nling\ngoddess\nmanager\nsweety\ntitans\nfang\nficken\nniners\nbubble\nibanez\nsweetpea\nstocking\n323232\ntornado\ncontent\naragorn\ntrojan\nchristop\nrockstar\ngeronimo\npascal\ncrimson\nfatcat\nlovelove\ncunts\nstimpy\nfinger\nwheels\nviper1\nlatin\ngreenday\ncreampie\nhiphop\nsnapper\nfuntime\nduck\ntrombone\nadult\ncookies\nmulder\nwestham\nlatino\njeep\nravens\ndrizzt\nmadness\nenergy\nkinky\n314159\nslick\nrocker\n55555555\nmongoose\nspeed\ndddddd\ncatdog\ncheng\nghost\ngogogo\ntottenha\ncurious\nbutterfl\nmission\njanuary\nshark\ntechno\nlancer\nlalala\nchichi\norion\ntrixie\ndelta\nbobbob\nbomber\nkang\n1968\nspunky\nliquid\nbeagle\ngranny\nnetwork\nkkkkkk\n1973\nbiggie\nbeetle\nteacher\ntoronto\nanakin\ngenius\ncocks\ndang\nkarate\nsnakes\nbangkok\nfuckyou2\npacific\ndaytona\ninfantry\nskywalke\nsailing\nraistlin\nvanhalen\nhuang\nblackie\ntarzan\nstrider\nsherlock\ngong\ndietcoke\nultimate\nshai\nsprite\nting\nartist\nchai\nchao\ndevil\npython\nninja\nytrewq\nsuperfly\n456789\ntian\njing\njesus1\nfreedom1\ndrpepper\nchou\nhobbit\nshen\nnolimit\nmylove\nbiscuit\nyahoo\nshasta\nsex4me\nsmoker\npebbles\npics\nphilly\ntong\ntintin\nlesbians\ncactus\nfrank1\ntttttt\nchun\ndanni\nemerald\nshowme\npirates\nlian\ndogg\nxiao\nxian\ntazman\ntanker\ntoshiba\ngotcha\nrang\nkeng\njazz\nbigguy\nyuan\ntomtom\nchaos\nfossil\nracerx\ncreamy\nbobo\nmusicman\nwarcraft\nblade\nshuang\nshun\nlick\njian\nmicrosoft\nrong\nfeng\ngetsome\nquality\n1977\nbeng\nwwwwww\nyoyoyo\nzhang\nseng\nharder\nqazxsw\nqian\ncong\nchuan\ndeng\nnang\nboeing\nkeeper\nwestern\n1963\nsubaru\nsheng\nthuglife\nteng\njiong\nmiao\nmang\nmaniac\npussie\na1b2c3\nzhou\nzhuang\nxing\nstonecol\nspyder\nliang\njiang\nmemphis\nceng\nmagic1\nlogitech\nchuang\nsesame\nshao\npoison\ntitty\nkuan\nkuai\nmian\nguan\nhamster\nguai\nferret\ngeng\nduan\npang\nmaiden\nquan\nvelvet\nnong\nneng\nnookie\nbuttons\nbian\nbingo\nbiao\nzhong\nzeng\nzhun\nying\nzong\nxuan\nzang\n0.0.000\nsuan\nshei\nshui\nsharks\nshang\nshua\npeng\npian\npiao\nliao\nmeng\nmiami\nreng\nguang\ncang\nruan\ndiao\nluan\nqing\nchui\nchuo\ncuan\nnuan\nning\nheng\nhuan\nkansas\nmuscle\nweng\n1passwor\nbluemoon\nzhui\nzhua\nxiang\nzheng\nzhen\nzhei\nzhao\nzhan\nyomama\nzhai\nzhuo\nzuan\ntarheel\nshou\nshuo\ntiao\nleng\nkuang\njiao\n13579\nbasket\nqiao\nqiong\nqiang\nchuai\nnian\nniao\nniang\nhuai\n22222222\nzhuan\nzhuai\nshuan\nshuai\nstardust\njumper\n66666666\ncharlott\nqwertz\nbones\nwaterloo\n2002\n11223344\noldman\ntrains\nvertigo\n246810\nblack1\nswallow\nsmiles\nstandard\nalexandr\nparrot\nuser\n1976\nsurfing\npioneer\napple1\nasdasd\nauburn\nhannibal\nfrontier\npanama\nvette\nblue22\nshemale\n111222\nbaggins\ngroovy\nglobal\n181818\n1979\nblades\nspanking\nbyteme\nlobster\ndawg\njapanese\n1970\n1964\n2424\npolo\ncoco\ndeedee\nmikey\n1972\n171717\n1701\nstrip\njersey\ngreen1\ncapital\nputter\nvader\nseven7\nbanshee\ngrendel\ndicks\nhidden\niloveu\n1980\nledzep\n147258\nfemale\nbugger\nbuffett\nmolson\n2020\nwookie\nsprint\njericho\n102030\nranger1\ntrebor\ndeepthroat\nbonehead\nmolly1\nmirage\nmodels\n1984\n2468\nshowtime\nsquirrel\npentium\nanime\ngator\npowder\ntwister\nconnect\nneptune\nengine\neatshit\nmustangs\nwoody1\nshogun\nseptembe\npooh\njimbo\nrussian\nsabine\nvoyeur\n2525\n363636\ncamel\ngermany\ngiant\nqqqq\nnudist\nbone\nsleepy\ntequila\nfighter\nobiwan\nmakaveli\nvacation\nwalnut\n1974\nladybug\ncantona\nccbill\nsatan\nrusty1\npasswor1\ncolumbia\nkissme\nmotorola\nwilliam1\n1967\nzzzz\nskater\nsmut\nmatthew1\nvalley\ncoolio\ndagger\nboner\nbull\nhorndog\njason1\npenguins\nrescue\ngriffey\n8j4ye3uz\ncaliforn\nchamps\nportland\ncolt45\nxxxxxxx\nxanadu\ntacoma\ncarpet\ngggggg\nsafety\npalace\nitalia\npicturs\npicasso\nthongs\ntempest\nasd123\nhairy\nfoxtrot\nnimrod\nhotboy\n343434\n1111111\ngoose\noverlord\nstranger\n454545\nshaolin\nsooners\nsocrates\nspiderman\npeanuts\n13131313\nandrew1\nfilthy\nohyeah\nafrica\nintrepid\npickles\nassass\nfright\npotato\nhhhhhh\nkingdom\nweezer\n424242\npepsi1\nthroat\nlooker\npuppy\nbutch\nsweets\nmegadeth\nanalsex\nnymets\nddddddd\nbigballs\noakland\noooooo\nqweasd\nchucky\ncarrot\nchargers\ndiscover\ndookie\ncondor\nhorny1\nsunrise\nsinner\njojo\nmegapass\nmartini\nassfuck\nffffff\nmushroom\njamaica\n7654321\n77777\ncccccc\ngizmodo\ntractor\nmypass\nhongkong\n1975\nblue123\npissing\nthomas1\nredred\nbasketball\nsatan666\ndublin\nbollox\nkingkong\n1971\n22222\n272727\nsexx\nbbbb\ngrizzly\npassat\ndefiant\nbowler\nknickers\nwisdom\nslappy\nthor\nletsgo\nrobert1\nbrownie\n098765\nplaytime\nlightnin\natomic\ngoku\nllllll\nqwaszx\ncosmos\nbosco\nknights\nbeast\nslapshot\nassword\nfrosty\ndumbass\nmallard\ndddd\n159357\ntitleist\naussie\ngolfing\ndoobie\nloveit\nwerewolf\nvipers\n1965\nblabla\nsurf\nsucking\ntardis\nthegame\nlegion\nrebels\nsarah1\nonelove\nloulou\ntoto\nblackcat\n0007\ntacobell\nsoccer1\njedi\nmethod\npoopie\nboob\nbreast\nkittycat\nbelly\npikachu\nthunder1\nthankyou\nceltics\nfrogger\nscoobydo\nsabbath\ncoltrane\nbudman\njackal\nzzzzz\nlicking\ngopher\ngeheim\nlonestar\nprimus\npooper\nnewpass\nbrasil\nheather1\nhusker\nelement\nmoomoo\nbeefcake\nzzzzzzzz\nshitty\nsmokin\njjjj\nanthony1\nanubis\nbackup\ngorilla\nfuckface\nlowrider\npunkrock\ntraffic\ndelta1\namazon\nfatass\ndodgeram\ndingdong\nqqqqqqqq\nbreasts\nboots\nhonda1\nspidey\npoker\ntemp\njohnjohn\n147852\nasshole1\ndogdog\ntricky\ncrusader\nsyracuse\nspankme\nspeaker\nmeridian\namadeus\nharley1\nfalcons\nturkey50\nkenwood\nkeyboard\nilovesex\n1978\nshazam\nshalom\nlickit\njimbob\nroller\nfatman\nsandiego\nmagnus\ncooldude\nclover\nmobile\nplumber\ntexas1\ntool\ntopper\nmariners\nrebel\ncaliente\ncelica\noxford\nosiris\norgasm\npunkin\nporsche9\ntuesday\nbreeze\nbossman\nkangaroo\nlatinas\nastros\nscruffy\nqwertyu\nhearts\njammer\njava\n1122\ngoodtime\nchelsea1\nfreckles\nflyboy\ndoodle\nnebraska\nbootie\nkicker\nwebmaster\nvulcan\n191919\nblueeyes\n321321\nfarside\nrugby\ndirector\npussy69\npower1\nhershey\nhermes\nmonopoly\nbirdman\nblessed\nblackjac\nsouthern\npeterpan\nthumbs\nfuckyou1\nrrrrrr\na1b2c3d4\ncoke\nbohica\nelvis1\nblacky\nsentinel\nsnake1\nrichard1\n1234abcd\nguardian\ncandyman\nfisting\nscarlet\ndildo\npancho\nmandingo\nlucky7\ncondom\nmunchkin\nbillyboy\nsummer1\nsword\nskiing\nsite\nsony\nthong\nrootbeer\nassassin\nfffff\nfitness\ndurango\npostal\nachilles\nkisses\nwarriors\nplymouth\ntopdog\nasterix\nhallo\ncameltoe\nfuckfuck\neeeeee\nsithlord\ntheking\navenger\nbackdoor\nchevrole\ntrance\ncosworth\nhouses\nhomers\neternity\nkingpin\nverbatim\nincubus\n1961\nblond\nzaphod\nshiloh\nspurs\nmighty\naliens\ncharly\ndogman\nomega1\nprinter\naggies\ndeadhead\nbitch1\nstone55\npineappl\nthekid\nrockets\ncamels\nformula\noracle\npussey\nporkchop\nabcde\nclancy\nmystic\ninferno\nblackdog\nsteve1\nalfa\ngrumpy\nflames\npuffy\nproxy\nvalhalla\nunreal\nherbie\nengage\nyyyyyy\n010101\npistol\nceleb\ngggg\nportugal\na12345\nnewbie\nmmmm\n1qazxsw2\nzorro\nwriter\nstripper\nsebastia\nspread\nlinks\nmetal\n1221\n565656\nfunfun\ntrojans\ncyber\nhurrican\nmoneys\n1x2zkg8w\nzeus\ntomato\nlion\natlantic\nusa123\ntrans\naaaaaaa\nhomerun\nhyperion\nkevin1\nblacks\n44444444\nskittles\nfart\ngangbang\nfubar\nsailboat\noilers\nbuster1\nhithere\nimmortal\nsticks\npilot\nlexmark\njerkoff\nmaryland\ncheers\npossum\ncutter\nmuppet\nswordfish\nsport\nsonic\npeter1\njethro\nrockon\nasdfghj\npass123\npornos\nncc1701a\nbootys\nbuttman\nbonjour\n1960\nbears\n362436\nspartans\ntinman\nthreesom\nmaxmax\n1414\nbbbbb\ncamelot\nchewie\ngogo\nfusion\nsaint\ndilligaf\nnopass\nhustler\nhunter1\nwhitey\nbeast1\nyesyes\nspank\nsmudge\npinkfloy\npatriot\nlespaul\nhammers\nformula1\nsausage\nscooter1\norioles\noscar1\ncolombia\ncramps\nexotic\niguana\nsuckers\nslave\ntopcat\nlancelot\nmagelan\nracer\ncrunch\nbritish\nsteph\n456123\nskinny\nseeking\nrockhard\nfilter\nfreaks\nsakura\npacman\npoontang\nnewlife\nhomer1\nklingon\nwatcher\nwalleye\ntasty\nsinatra\nstarship\nsteel\nstarbuck\nponcho\namber1\ngonzo\ncatherin\ncandle\nfirefly\ngoblin\nscotch\ndiver\nusmc\nhuskies\nkentucky\nkitkat\nbeckham\nbicycle\nyourmom\nstudio\n33333333\nsplash\njimmy1\n12344321\nsapphire\nmailman\nraiders1\nddddd\nexcalibu\nillini\nimperial\nlansing\nmaxx\ngothic\ngolfball\nfacial\nfront242\nmacdaddy\nqwer1234\nvectra\ncowboys1\ncrazy1\ndannyboy\naquarius\nfranky\nffff\nsassy\npppp\npppppppp\nprodigy\nnoodle\neatpussy\nvortex\nwanking\nbilly1\nsiemens\nphillies\ngroups\nchevy1\ncccc\ngggggggg\ndoughboy\ndracula\nnurses\nloco\nlollipop\nutopia\nchrono\ncooler\nnevada\nwibble\nsummit\n1225\ncapone\nfugazi\npanda\nqazwsxed\npuppies\ntriton\n9876\nnnnnnn\nmomoney\niforgot\nwolfie\nstudly\nhamburg\n81fukkc\n741852\ncatman\nchina\ngagging\nscott1\noregon\nqweqwe\ncrazybab\ndaniel1\ncutlass\nholes\nmothers\nmusic1\nwalrus\n1957\nbigtime\nxtreme\nsimba\nssss\nrookie\nbathing\nrotten\nmaestro\nturbo1\n99999\nbutthole\nhhhh\nyoda\nshania\nphish\nthecat\nrightnow\nbaddog\ngreatone\ngateway1\nabstr\nnapster\nbrian1\nbogart\nhitler\nwildfire\njackson1\n1981\nbeaner\nyoyo\n0.0.0.000\nsuper1\nselect\nsnuggles\nslutty\nphoenix1\ntechnics\ntoon\nraven1\nrayray\n123789\n1066\nalbion\ngreens\ngesperrt\nbrucelee\nhehehe\nkelly1\nmojo\n1998\nbikini\nwoofwoof\nyyyy\nstrap\nsites\ncentral\nf**k\nnyjets\npunisher\nusername\nvanilla\ntwisted\nbunghole\nviagra\nveritas\npony\ntitts\nlabtec\njenny1\nmasterbate\nmayhem\nredbull\ngovols\ngremlin\n505050\ngmoney\nrovers\ndiamond1\ntrident\nabnormal\ndeskjet\ncuddles\nbristol\nmilano\nvh5150\njarhead\n1982\nbigbird\nbizkit\nsixers\nslider\nstar69\nstarfish\npenetration\ntommy1\njohn316\ncaligula\nflicks\nfilms\nrailroad\ncosmo\ncthulhu\nbr0d3r\nbearbear\nswedish\nspawn\npatrick1\nreds\nanarchy\ngroove\nfuckher\noooo\nairbus\ncobra1\nclips\ndelete\nduster\nkitty1\nmouse1\nmonkeys\njazzman\n1919\n262626\nswinging\nstroke\nstocks\nsting\npippen\nlabrador\njordan1\njustdoit\nmeatball\nfemales\nvector\ncooter\ndefender\nnike\nbubbas\nbonkers\nkahuna\nwildman\n4121\nsirius\nstatic\npiercing\nterror\nteenage\nleelee\nmicrosof\nmechanic\nrobotech\nrated\nchaser\nsalsero\nmacross\nquantum\ntsunami\ndaddy1\ncruise\nnewpass6\nnudes\nhellyeah\n1959\nstriker\nspice\nspectrum\nsmegma\nthumb\njjjjjjjj\nmellow\ncancun\ncartoon\nsabres\nsamiam\noranges\noklahoma\nlust\ndenali\nnude\nnoodles\nbrest\nhooter\nmmmmmmmm\nwarthog\nblueblue\nzappa\nwolverine\nsniffing\njjjjj\ncalico\nfreee\nrover\npooter\ncloseup\nbonsai\nemily1\nkeystone\niiii\n1955\nyzerman\ntheboss\ntolkien\nmegaman\nrasta\nbbbbbbbb\nhal9000\ngoofy\ngringo\ngofish\ngizmo1\nsamsam\nscuba\nonlyme\ntttttttt\ncorrado\nclown\nclapton\nbulls\njayhawk\nwwww\nsharky\nseeker\nssssssss\npillow\nthesims\nlighter\nlkjhgf\nmelissa1\nmarcius2\nguiness\ngymnast\ncasey1\ngoalie\ngodsmack\nlolo\nrangers1\npoppy\nclemson\nclipper\ndeeznuts\nholly1\neeee\nkingston\nyosemite\nsucked\nsex123\nsexy69\npic's\ntommyboy\nmasterbating\ngretzky\nhappyday\nfrisco\norchid\norange1\nmanchest\naberdeen\nne1469\nboxing\nkorn\nintercourse\n161616\n1985\nziggy\nsupersta\nstoney\namature\nbabyboy\nbcfields\ngoliath\nhack\nhardrock\nfrodo\nscout\nscrappy\nqazqaz\ntracker\nactive\ncraving\ncommando\ncohiba\ncyclone\nbubba69\nkatie1\nmpegs\nvsegda\nirish1\nsexy1\nsmelly\nsquerting\nlions\njokers\njojojo\nmeathead\nashley1\ngroucho\ncheetah\nchamp\nfirefox\ngandalf1\npacker\nlove69\ntyler1\ntyphoon\ntundra\nbobby1\nkenworth\nvillage\nvolley\nwolf359\n0420\n000007\nswimmer\nskydive\nsmokes\npeugeot\npompey\nlegolas\nredhot\nrodman\nredalert\ngrapes\n4runner\ncarrera\nfloppy\nou8122\nquattro\ncloud9\ndavids\nnofear\nbusty\nhomemade\nmmmmm\nwhisper\nvermont\nwebmaste\nwives\ninsertion\njayjay\nphilips\ntopher\ntemptress\nmidget\nripken\nhavefun\ncanon\ncelebrity\nghetto\nragnarok\nusnavy\nconover\ncruiser\ndalshe\nnicole1\nbuzzard\nhottest\nkingfish\nmisfit\nmilfnew\nwarlord\nwassup\nbigsexy\nblackhaw\nzippy\ntights\nkungfu\nlabia\nmeatloaf\narea51\nbatman1\nbananas\n636363\nggggg\nparadox\nqueens\nadults\naikido\ncigars\nhoosier\neeyore\nmoose1\nwarez\ninteracial\nstreaming\n313131\npertinant\npool6123\nmayday\nanimated\nbanker\nbaddest\ngordon24\nccccc\nfantasies\naisan\ndeadman\nhomepage\nejaculation\nwhocares\niscool\njamesbon\n1956\n1pussy\nwomam\nsweden\nskidoo\nspock\nsssss\npepper1\npinhead\nmicron\nallsop\namsterda\ngunnar\n666999\nfebruary\nfletch\ngeorge1\nsapper\nsasha1\nluckydog\nlover1\nmagick\npopopo\nultima\ncypress\nbusinessbabe\nbrandon1\nvulva\nvvvv\njabroni\nbigbear\nyummy\n010203\nsearay\nsecret1\nsinbad\nsexxxx\nsoleil\nsoftware\npiccolo\nthirteen\nleopard\nlegacy\nmemorex\nredwing\nrasputin\n134679\nanfield\ngreenbay\ncatcat\nfeather\nscanner\npa55word\ncontortionist\ndanzig\ndaisy1\nhores\nexodus\niiiiii\n1001\nsubway\nsnapple\nsneakers\nsonyfuck\npicks\npoodle\ntest1234\nllll\njunebug\nmarker\nmellon\nronaldo\nroadkill\namanda1\nasdfjkl\nbeaches\ngreat1\ncheerleaers\ndoitnow\nozzy\nboxster\nbrighton\nhousewifes\nkkkk\nmnbvcx\nmoocow\nvides\n1717\nbigmoney\nblonds\n1000\nstorys\nstereo\n4545\n420247\nseductive\nsexygirl\nlesbean\njustin1\n124578\ncabbage\ncanadian\ngangbanged\ndodge1\ndimas\nmalaka\npuss\nprobes\ncoolman\nnacked\nhotpussy\nerotica\nkool\nimplants\nintruder\nbigass\nzenith\nwoohoo\nwomans\ntango\npisces\nlaguna\nmaxell\nandyod22\nbarcelon\nchainsaw\nchickens\nflash1\norgasms\nmagicman\nprofit\npusyy\npothead\ncoconut\nchuckie\nclevelan\nbuilder\nbudweise\nhotshot\nhorizon\nexperienced\nmondeo\nwifes\n1962\nstumpy\nsmiths\nslacker\npitchers\npasswords\nlaptop\nallmine\nalliance\nbbbbbbb\nasscock\nhalflife\n88888\nchacha\nsaratoga\nsandy1\ndoogie\nqwert40\ntransexual\nclose-up\nib6ub9\nvolvo\njacob1\niiiii\nbeastie\nsunnyday\nstoned\nsonics\nstarfire\nsnapon\npictuers\npepe\ntesting1\ntiberius\nlisalisa\nlesbain\nlitle\nretard\nripple\naustin1\nbadgirl\ngolfgolf\nflounder\nroyals\ndragoon\ndickie\npasswor\nmajestic\npoppop\ntrailers\nnokia\nbobobo\nbr549\nminime\nmikemike\nwhitesox\n1954\n3232\n353535\nseamus\nsolo\nsluttey\npictere\ntitten\nlback\n1024\ngoodluck\nfingerig\ngallaries\ngoat\npassme\noasis\nlockerroom\nlogan1\nrainman\ntreasure\ncustom\ncyclops\nnipper\nbucket\nhomepage-\nhhhhh\nmomsuck\nindain\n2345\nbeerbeer\nbimmer\nstunner\n456456\ntootsie\ntesterer\nreefer\n1012\nharcore\ngollum\n545454\nchico\ncaveman\nfordf150\nfishes\ngaymen\nsaleen\ndoodoo\npa55w0rd\npresto\nqqqqq\ncigar\nbogey\nhelloo\ndutch\nkamikaze\nwasser\nvietnam\nvisa\njapanees\n0123\nswords\nslapper\npeach\nmasterbaiting\nredwood\n1005\nametuer\nchiks\nfucing\nsadie1\npanasoni\nmamas\nrambo\nunknown\nabsolut\ndallas1\nhousewife\nkeywest\nkipper\n18436572\n1515\nzxczxc\n303030\nshaman\nterrapin\nmasturbation\nmick\nredfish\n1492\nangus\ngoirish\nhardcock\nforfun\ngalary\nfreeporn\nduchess\nolivier\nlotus\npornographic\nramses\npurdue\ntraveler\ncrave\nbrando\nenter1\nkillme\nmoneyman\nwelder\nwindsor\nwifey\nindon\nyyyyy\ntaylor1\n4417\npicher\npickup\nthumbnils\njohnboy\njets\nameteur\namateurs\napollo13\nhambone\ngoldwing\n5050\nsally1\ndoghouse\npadres\npounding\nquest\ntruelove\nunderdog\ntrader\nclimber\nbolitas\nhohoho\nbeanie\nberetta\nwrestlin\nstroker\nsexyman\njewels\njohannes\nmets\nrhino\nbdsm\nballoons\ngrils\nhappy123\nflamingo\nroute66\ndevo\noutkast\npaintbal\nmagpie\nllllllll\ntwilight\ncritter\ncupcake\nnickel\nbullseye\nknickerless\nvideoes\nbinladen\nxerxes\nslim\nslinky\npinky\nthanatos\nmeister\nmenace\nretired\nalbatros\nballoon\ngoten\n5551212\ngetsdown\ndonuts\nnwo4life\ntttt\ncomet\ndeer\ndddddddd\ndeeznutz\nnasty1\nnonono\nenterprise\neeeee\nmisfit99\nmilkman\nvvvvvv\n1818\nblueboy\nbigbutt\ntech\ntoolman\njuggalo\njetski\nbarefoot\n50spanks\ngobears\nscandinavian\ncubbies\nnitram\nkings\nbilbo\nyumyum\nzzzzzzz\nstylus\n321654\nshannon1\nserver\nsquash\nstarman\nsteeler\nphrases\ntechniques\nlaser\n135790\nathens\ncbr600\nchemical\nfester\ngangsta\nfucku2\ndroopy\nobjects\npasswd\nlllll\nmanchester\nvedder\nclit\nchunky\ndarkman\nbuckshot\nbuddah\nboobed\nhenti\nwinter1\nbigmike\nbeta\nzidane\ntalon\nslave1\npissoff\nthegreat\nlexus\nmatador\nreaders\narmani\ngoldstar\n5656\nfmale\nfuking\nfucku\nggggggg\nsauron\ndiggler\npacers\nlooser\npounded\npremier\ntriangle\ncosmic\ndepeche\nnorway\nhelmet\nmustard\nmisty1\njagger\n3x7pxr\nsilver1\nsnowboar\npenetrating\nphotoes\nlesbens\nlindros\nroadking\nrockford\n1357\n143143\nasasas\ngoodboy\n898989\nchicago1\nferrari1\ngaleries\ngodfathe\ngawker\ngargoyle\ngangster\nrubble\nrrrr\nonetime\npussyman\npooppoop\ntrapper\ncinder\nnewcastl\nboricua\nbunny1\nboxer\nhotred\nhockey1\nedward1\nmortgage\nbigtit\nsnoopdog\njoshua1\njuly\n1230\nassholes\nfrisky\nsanity\ndivine\ndharma\nlucky13\nakira\nbutterfly\nhotbox\nhootie\nhowdy\nearthlink\nkiteboy\nwestwood\n1988\nblackbir\nbiggles\nwrench\nwrestle\nslippery\npheonix\npenny1\npianoman\nthedude\njenn\njonjon\njones1\nroadrunn\narrow\nazzer\nseahawks\ndiehard\ndotcom\ntunafish\nchivas\ncinnamon\nclouds\ndeluxe\nnorthern\nboobie\nmomomo\nmodles\nvolume\n23232323\nbluedog\nwwwwwww\nzerocool\nyousuck\npluto\nlimewire\njoung\nawnyce\ngonavy\nhaha\nfilms+pic+galeries\ngirsl\nfuckthis\ngirfriend\nuncencored\na123456\nchrisbln\ncombat\ncygnus\ncupoi\nnetscape\nhhhhhhhh\neagles1\nelite\nknockers\n1958\ntazmania\nshonuf\npharmacy\nthedog\nmidway\narsenal1\nanaconda\naustrali\ngromit\ngotohell\n787878\n66666\ncarmex2\ncamber\ngator1\nginger1\nfuzzy\nseadoo\nlovesex\nrancid\nuuuuuu\n911911\nbulldog1\nheater\nmonalisa\nmmmmmmm\nwhiteout\nvirtual\njamie1\njapanes\njames007\n2727\n2469\nblam\nbitchass\nzephyr\nstiffy\nsweet1\nsouthpar\nspectre\ntigger1\ntekken\nlakota\nlionking\njjjjjjj\nmegatron\n1369\nhawaiian\ngymnastic\ngolfer1\ngunners\n7779311\n515151\nsanfran\noptimus\npanther1\nlove1\nmaggie1\npudding\naaron1\ndelphi\nniceass\nbounce\nhouse1\nkiller1\nmomo\nmusashi\njammin\n2003\n234567\nwp2003wp\nsubmit\nsssssss\nspikes\nsleeper\npasswort\nkume\nmeme\nmedusa\nmantis\nreebok\n1017\nartemis\nharry1\ncafc91\nfettish\noceans\noooooooo\nmango\nppppp\ntrainer\nuuuu\n909090\ndeath1\nbullfrog\nhokies\nholyshit\neeeeeee\njasmine1\n&amp\n&amp;\nspinner\njockey\nbabyblue\ngooner\n474747\ncheeks\npass1234\nparola\nokokok\nposeidon\n989898\ncrusher\ncubswin\nnnnn\nkotaku\nmittens\nwhatsup\nvvvvv\niomega\ninsertions\nbengals\nbiit\nyellow1\n012345\nspike1\nsowhat\npitures\npecker\ntheend\nhayabusa\nhawkeyes\nflorian\nqaz123\nusarmy\ntwinkle\nchuckles\nhounddog\nhover\nhothot\neuropa\nkenshin\nkojak\nmikey1\nwater1\n196969\nwraith\nzebra\nwwwww\n33333\nsimon1\nspider1\nsnuffy\nphilippe\nthunderb\nteddy1\nmarino13\nmaria1\nredline\nrenault\naloha\nhandyman\ncerberus\ngamecock\ngobucks\nfreesex\nduffman\nooooo\nnuggets\nmagician\nlongbow\npreacher\nporno1\nchrysler\ncontains\ndalejr\nnavy\nbuffy1\nhedgehog\nhoosiers\nhoney1\nhott\nheyhey\ndutchess\neverest\nwareagle\nihateyou\nsunflowe\n3434\nsenators\nshag\nspoon\nsonoma\nstalker\npoochie\nterminal\nterefon\nmaradona\n1007\n142536\nalibaba\namerica1\nbartman\nastro\ngoth\nchicken1\ncheater\nghost1\npasspass\noral\nr2d2c3po\ncivic\ncicero\nmyxworld\nkkkkk\nmissouri\nwishbone\ninfiniti\n1a2b3c\n1qwerty\nwonderboy\nshojou\nsparky1\nsmeghead\npoiuy\ntitanium\nlantern\njelly\n1213\nbayern\nbasset\ngsxr750\ncattle\nfishing1\nfullmoon\ngilles\ndima\nobelix\npopo\nprissy\nramrod\nbummer\nhotone\ndynasty\nentry\nkonyor\nmissy1\n282828\nxyz123\n426hemi\n404040\nseinfeld\npingpong\nlazarus\nmarine1\n12345a\nbeamer\nbabyface\ngreece\ngustav\n7007\nccccccc\nfaggot\nfoxy\ngladiato\nduckie\ndogfood\npackers1\nlongjohn\nradical\ntuna\nclarinet\ndanny1\nnovell\nbonbon\nkashmir\nkiki\nmortimer\nmodelsne\nmoondog\nvladimir\ninsert\n1953\nzxc123\nsupreme\n3131\nsexxx\nsoftail\npoipoi\npong\nmars\nmartin1\nrogue\navalanch\naudia4\n55bgates\ncccccccc\ncame11\nfigaro\ndogboy\ndnsadm\ndipshit\nparadigm\nothello\noperator\ntripod\nchopin\ncoucou\ncocksuck\nborussia\nheritage\nhiziad\nhomerj\nmullet\nwhisky\n4242\nspeedo\nstarcraf\nskylar\nspaceman\npiggy\ntiger2\nlegos\njezebel\njoker1\nmazda\n727272\nchester1\nrrrrrrrr\ndundee\nlumber\nppppppp\ntranny\naaliyah\nadmiral\ncomics\ndelight\nbuttfuck\nhomeboy\neternal\nkilroy\nviolin\nwingman\nwalmart\nbigblue\nblaze\nbeemer\nbeowulf\nbigfish\nyyyyyyy\nwoodie\nyeahbaby\n0123456\ntbone\nsyzygy\nstarter\nlinda1\nmerlot\nmexican\n11235813\nbanner\nbangbang\nbadman\nbarfly\ngrease\ncharles1\nffffffff\ndoberman\ndogshit\noverkill\ncoolguy\nclaymore\ndemo\nnomore\nhhhhhhh\nhondas\niamgod\nenterme\nelectron\neastside\nminimoni\nmybaby\nwildbill\nwildcard\nipswich\n200000\nbearcat\nzigzag\nyyyyyyyy\nsweetnes\n369369\nskyler\nskywalker\npigeon\ntipper\nasdf123\nalphabet\nasdzxc\nbabybaby\nbanane\nguyver\ngraphics\nchinook\nflorida1\nflexible\nfuckinside\nursitesux\ntototo\nadam12\nchristma\nchrome\nbuddie\nbombers\nhippie\nmisfits\n292929\nwoofer\nwwwwwwww\nstubby\nsheep\nsparta\nstang\nspud\nsporty\npinball\njust4fun\nmaxxxx\nrebecca1\nfffffff\nfreeway\ngarion\nrrrrr\nsancho\noutback\nmaggot\npuddin\n987456\nhoops\nmydick\n19691969\nbigcat\nshiner\nsilverad\ntemplar\nlamer\njuicy\nmike1\nmaximum\n1223\n10101010\narrows\nalucard\nhaggis\ncheech\nsafari\ndog123\norion1\npaloma\nqwerasdf\npresiden\nvegitto\n969696\nadonis\ncookie1\nnewyork1\nbuddyboy\nhellos\nheineken\neraser\nmoritz\nmillwall\nvisual\njaybird\n1983\nbeautifu\nzodiac\nsteven1\nsinister\nslammer\nsmashing\nslick1\nsponge\nteddybea\nticklish\njonny\n1211\naptiva\napplepie\nbailey1\nguitar1\ncanyon\ngagged\nfuckme1\ndigital1\ndinosaur\n98765\n90210\nclowns\ncubs\ndeejay\nnigga\nnaruto\nboxcar\nicehouse\nhotties\nelectra\nwidget\n1986\n2004\nbluefish\nbingo1\n*****\nstratus\nsultan\nstorm1\n44444\n4200\nsentnece\nsexyboy\nsigma\nsmokie\nspam\npippo\ntemppass\nmanman\n1022\nbacchus\naztnm\naxio\nbamboo\nhakr\ngregor\nhahahaha\n5678\ncamero1\ndolphin1\npaddle\nmagnet\nqwert1\npyon\nporsche1\ntripper\nnoway\nburrito\nbozo\nhighheel\nhookem\neddie1\nentropy\nkkkkkkkk\nkkkkkkk\nillinois\n1945\n1951\n24680\n21212121\n100000\nstonecold\ntaco\nsubzero\nsexxxy\nskolko\nskyhawk\nspurs1\nsputnik\ntestpass\njiggaman\n1224\nhannah1\n525252\n4ever\ncarbon\nscorpio1\nrt6ytere\nmadison1\nloki\ncoolness\ncoldbeer\ncitadel\nmonarch\nmorgan1\nwashingt\n1997\nbella1\nyaya\nsuperb\ntaxman\nstudman\n3636\npizzas\ntiffany1\nlassie\nlarry1\njoseph1\nmephisto\nreptile\nrazor\n1013\nhammer1\ngypsy\ngrande\ncamper\nchippy\ncat123\nchimera\nfiesta\nglock\ndomain\ndieter\ndragonba\nonetwo\nnygiants\npassword2\nquartz\nprowler\nprophet\ntowers\nultra\ncocker\ncorleone\ndakota1\ncumm\nnnnnnnn\nboxers\nheynow\niceberg\nkittykat\nwasabi\nvikings1\nbeerman\nsplinter\nsnoopy1\npipeline\nmickey1\nmermaid\nmicro\nmeowmeow\nredbird\nbaura\nchevys\ncaravan\nfrogman\ndiving\ndogger\ndraven\ndrifter\noatmeal\nparis1\nlongdong\nquant4307s\nrachel1\nvegitta\ncobras\ncorsair\ndadada\nmylife\nbowwow\nhotrats\neastwood\nmoonligh\nmodena\nillusion\niiiiiii\njayhawks\nswingers\nshocker\nshrimp\nsexgod\nsquall\npoiu\ntigers1\ntoejam\ntickler\njulie1\njimbo1\njefferso\nmichael2\nrodeo\nrobot\n1023\nannie1\nbball\nhappy2\ncharter\nflasher\nfalcon1\nfiction\nfastball\ngadget\nscrabble\ndiaper\ndirtbike\noliver1\npaco\nmacman\npoopy\npopper\npostman\nttttttt\nacura\ncowboy1\nconan\ndaewoo\nnemrac58\nnnnnn\nnextel\nbobdylan\neureka\nkimmie\nkcj9wx5n\nkillbill\nmusica\nvolkswag\nwage\nwindmill\nwert\nvintage\niloveyou1\nitsme\nzippo\n311311\nstarligh\nsmokey1\nsnappy\nsoulmate\nplasma\nkrusty\njust4me\nmarius\nrebel1\n1123\naudi\nfick\ngoaway\nrusty2\ndogbone\ndoofus\nooooooo\noblivion\nmankind\nmahler\nlllllll\npumper\npuck\npulsar\nvalkyrie\ntupac\ncompass\nconcorde\ncougars\ndelaware\nniceguy\nnocturne\nbob123\nboating\nbronze\nherewego\nhewlett\nhouhou\nearnhard\neeeeeeee\nmingus\nmobydick\nventure\nverizon\nimation\n1950\n1948\n1949\n223344\nbigbig\nwowwow\nsissy\nspiker\nsnooker\nsluggo\nplayer1\njsbach\njumbo\nmedic\nreddevil\nreckless\n123456a\n1125\n1031\nastra\ngumby\n757575\n585858\nchillin\nfuck1\nradiohea\nupyours\ntrek\ncoolcool\nclassics\nchoochoo\nnikki1\nnitro\nboytoy\nexcite\nkirsty\nwingnut\nwireless\nicu812\n1master\nbeatle\nbigblock\nwolfen\nsummer99\nsugar1\ntartar\nsexysexy\nsenna\nsexman\nsoprano\nplatypus\npixies\ntelephon\nlaura1\nlaurent\nrimmer\n1020\n12qwaszx\nhamish\nhalifax\nfishhead\nforum\ndododo\ndoit\nparamedi\nlonesome\nmandy1\nuuuuu\nuranus\nttttt\nbruce1\nhelper\nhopeful\neduard\ndusty1\nkathy1\nmoonbeam\nmuscles\nmonster1\nmonkeybo\nwindsurf\nvvvvvvv\nvivid\ninstall\n1947\n187187\n1941\n1952\nsusan1\n31415926\nsinned\nsexxy\nsmoothie\nsnowflak\nplaystat\nplaya\nplayboy1\ntoaster\njerry1\nmarie1\nmason1\nmerlin1\nroger1\nroadster\n112358\n1121\nandrea1\nbacardi\nhardware\n789789\n5555555\ncaptain1\nfergus\nsascha\nrrrrrrr\ndome\nonion\nlololo\nqqqqqqq\nundertak\nuuuuuuuu\nuuuuuuu\ncobain\ncindy1\ncoors\ndescent\nnimbus\nnomad\nnanook\nnorwich\nbombay\nbroker\nhookup\nkiwi\nwinners\njackpot\n1a2b3c4d\n1776\nbeardog\nbighead\nbird33\n0987\nspooge\npelican\npeepee\ntitan\nthedoors\njeremy1\naltima\nbaba\nhardone\n5454\ncatwoman\nfinance\nfarmboy\nfarscape\ngenesis1\nsalomon\nloser1\nr2d2\npumpkins\nchriss\ncumcum\nninjas\nninja1\nkillers\nmiller1\nislander\njamesbond\nintel\n19841984\n2626\nbizzare\nblue12\nbiker\nyoyoma\nsushi\nshitface\nspanker\nsteffi\nsphinx\nplease1\npaulie\npistons\ntiburon\nmaxwell1\nmdogg\nrockies\narmstron\nalejandr\narctic\nbanger\naudio\nasimov\n753951\n4you\nchilly\ncare1839\nflyfish\nfantasia\nfreefall\nsandrine\noreo\nohshit\nmacbeth\nmadcat\nloveya\nqwerqwer\ncolnago\nchocha\ncobalt\ncrystal1\ndabears\nnevets\nnineinch\nbroncos1\nepsilon\nkestrel\nwinston1\nwarrior1\niiiiiiii\niloveyou2\n1616\nwoowoo\nsloppy\nspecialk\ntinkerbe\njellybea\nreader\nredsox1\n1215\n1112\narcadia\nbaggio\n555666\ncayman\ncbr900rr\ngabriell\nglennwei\nsausages\ndisco\npass1\nlovebug\nmacmac\npuffin\nvanguard\ntrinitro\nairwolf\naaa111\ncocaine\ncisco\ndatsun\nbricks\nbumper\neldorado\nkidrock\nwizard1\nwhiskers\nwildwood\nistheman\n25802580\nbigones\nwoodland\nwolfpac\nstrawber\n3030\nsheba1\nsixpack\npeace1\nphysics\ntigger2\ntoad\nmegan1\nmeow\nringo\namsterdam\n717171\n686868\n5424\ncanuck\nfootball1\nfootjob\nfulham\nseagull\norgy\nlobo\nmancity\nvancouve\nvauxhall\nacidburn\nderf\nmyspace1\nboozer\nbuttercu\nhola\nminemine\nmunch\n1dragon\nbiology\nbestbuy\nbigpoppa\nblackout\nblowfish\nbmw325\nbigbob\nstream\ntalisman\ntazz\nsundevil\n3333333\nskate\nshutup\nshanghai\nspencer1\nslowhand\npinky1\ntootie\nthecrow\njubilee\njingle\nmatrix1\nmanowar\nmessiah\nresident\nredbaron\nromans\nandromed\nathlon\nbeach1\nbadgers\nguitars\nharald\nharddick\ngotribe\n6996\n7grout\n5wr2i7h8\n635241\nchase1\nfallout\nfiddle\nfenris\nfrancesc\nfortuna\nfairlane\nfelix1\ngasman\nfucks\nsahara\nsassy1\ndogpound\ndogbert\ndivx1\nmanila\npornporn\nquasar\nvenom\n987987\naccess1\nclippers\ndaman\ncrusty\nnathan1\nnnnnnnnn\nbruno1\nbudapest\nkittens\nkerouac\nmother1\nwaldo1\nwhistler\nwhatwhat\nwanderer\nidontkno\n1942\n1946\nbigdawg\nbigpimp\nzaqwsx\n414141\n3000gt\n434343\nserpent\nsmurf\npasword\nthisisit\njohn1\nrobotics\nredeye\nrebelz\n1011\nalatam\nasians\nbama\nbanzai\nharvest\n575757\n5329\nfatty\nfender1\nflower2\nfunky\nsambo\ndrummer1\ndogcat\noedipus\nosama\nprozac\nprivate1\nrampage\nconcord\ncinema\ncornwall\ncleaner\nciccio\nclutch\ncorvet07\ndaemon\nbruiser\nboiler\nhjkl\negghead\nmordor\njamess\niverson3\nbluesman\nzouzou\n090909\n1002\nstone1\n4040\nsexo\nsmith1\nsperma\nsneaky\npolska\nthewho\nterminat\nkrypton\nlekker\njohnson1\njohann\nrockie\naspire\ngoodie\ncheese1\nfenway\nfishon\nfishin\nfuckoff1\ngirls1\ndoomsday\npornking\nramones\nrabbits\ntransit\naaaaa1\nboyz\nbookworm\nbongo\nbunnies\nbuceta\nhighbury\nhenry1\neastern\nmischief\nmopar\nministry\nvienna\nwildone\nbigbooty\nbeavis1\nxxxxxx1\nyogibear\n000001\n0815\nzulu\n420000\nsigmar\nsprout\nstalin\nlkjhgfds\nlagnaf\nrolex\nredfox\nreferee\n123123123\n1231\nangus1\nballin\nattila\ngreedy\ngrunt\n747474\ncarpedie\ncaramel\nfoxylady\ngatorade\nfutbol\nfrosch\nsaiyan\ndrums\ndonner\ndoggy1\ndrum\ndoudou\nnutmeg\nquebec\nvaldepen\ntosser\ntuscl\ncomein\ncola\ndeadpool\nbremen\nhotass\nhotmail1\neskimo\neggman\nkoko\nkieran\nkatrin\nkordell1\nkomodo\nmone\nmunich\nvvvvvvvv\njackson5\n2222222\nbergkamp\nbigben\nzanzibar\nxxx123\nsunny1\n373737\nslayer1\nsnoop\npeachy\nthecure\nlittle1\njennaj\nrasta69\n1114\naries\nhavana\ngratis\ncalgary\ncheckers\nflanker\nsalope\ndirty1\ndraco\ndogface\nluv2epus\nrainbow6\numpire\nturnip\nvbnm\ntucson\ntroll\ncodered\ncommande\nneon\nnico\nnightwin\nboomer1\nbushido\nhotmail0\nenternow\nkeepout\nkaren1\nmnbv\nviewsoni\nvolcom\nwizards\n1995\nberkeley\nwoodstoc\ntarpon\nshinobi\nstarstar\nphat\ntoolbox\njulien\njohnny1\njoebob\nriders\nreflex\n120676\n1235\nangelus\nanthrax\natlas\ngrandam\nharlem\nhawaii50\n655321\ncabron\nchalleng\ncallisto\nfirewall\nfirefire\nflyer\nflower1\ngambler\nfrodo1\nsam123\nscania\ndingo\npapito\npassmast\nou8123\nrandy1\ntwiggy\ntravis1\ntreetop\naddict\nadmin1\n963852\naceace\ncirrus\nbobdole\nbonjovi\nbootsy\nboater\nelway7\nkenny1\nmoonshin\nmontag\nwayne1\nwhite1\njazzy\njakejake\n1994\n1991\n2828\nbluejays\nbelmont\nsensei\nsouthpark\npeeper\npharao\npigpen\ntomahawk\nteensex\nleedsutd\njeepster\njimjim\njosephin\nmelons\nmatthias\nrobocop\n1003\n1027\nantelope\nazsxdc\ngordo\nhazard\ngranada\n8989\n7894\nceasar\ncabernet\ncheshire\nchelle\ncandy1\nfergie\nfidelio\ngiorgio\nfuckhead\ndominion\nqawsed\ntrucking\nchloe1\ndaddyo\nnostromo\nboyboy\nbooster\nbucky\nhonolulu\nesquire\ndynamite\nmollydog\nwindows1\nwaffle\nwealth\nvincent1\njabber\njaguars\njavelin\nirishman\nidefix\nbigdog1\nblue42\nblanked\nblue32\nbiteme1\nbearcats\nyessir\nsylveste\nsunfire\ntbird\nstryker\n3ip76k2\nsevens\npilgrim\ntenchi\ntitman\nleeds\nlithium\nlinkin\nmarijuan\nmariner\nmarkie\nmidnite\nreddwarf\n1129\n123asd\n12312312\nallstar\nalbany\nasdf12\naspen\nhardball\ngoldfing\n7734\n49ers\ncarnage\ncallum\ncarlos1\nfitter\nfandango\ngofast\ngamma\nfucmy69\nscrapper\ndogwood\ndjango\nmagneto\npremium\n9999999\nabc1234\nnewyear\nbookie\nbounty\nbrown1\nbologna\nelway\nkilljoy\nklondike\nmouser\nwayer\nimpreza\ninsomnia\n24682468\n2580\n24242424\nbillbill\nbellaco\nblues1\nblunts\nteaser\nsf49ers\nshovel\nsolitude\nspikey\npimpdadd\ntimeout\ntoffee\nlefty\njohndoe\njohndeer\nmega\nmanolo\nratman\nrobin1\n1124\n1210\n1028\n1226\nbabylove\nbarbados\ngramma\n646464\ncarpente\nchaos1\nfishbone\nfireblad\nfrogs\nscreamer\nscuba1\nducks\ndoggies\ndicky\nobsidian\nrams\ntottenham\naikman\ncomanche\ncorolla\ncumslut\ncyborg\nboston1\nhoudini\nhelmut\nelvisp\nkeksa12\nmonty1\nwetter\nwatford\nwiseguy\n1989\n1987\n20202020\nbiatch\nbeezer\nbigguns\nblueball\nbitchy\nwyoming\nyankees2\nwrestler\nstupid1\nsealteam\nsidekick\nsimple1\nsmackdow\nsporting\nspiral\nsmeller\nplato\ntophat\ntest2\ntoomuch\njello\njunkie\nmaxim\nmaxime\nmeadow\nremingto\nroofer\n124038\n1018\n1269\n1227\n123457\narkansas\naramis\nbeaker\nbarcelona\nbaltimor\ngoogoo\ngoochi\n852456\n4711\ncatcher\nchamp1\nfortress\nfishfish\nfirefigh\ngeezer\nrsalinas\nsamuel1\nsaigon\nscooby1\ndick1\ndoom\ndontknow\nmagpies\nmanfred\nvader1\nuniversa\ntulips\nmygirl\nbowtie\nholycow\nhoneys\nenforcer\nwaterboy\n1992\n23skidoo\nbimbo\nblue11\nbirddog\nzildjian\n030303\nstinker\nstoppedby\nsexybabe\nspeakers\nslugger\nspotty\nsmoke1\npolopolo\nperfect1\ntorpedo\nlakeside\njimmys\njunior1\nmasamune\n1214\napril1\ngrinch\n767676\n5252\ncherries\nchipmunk\ncezer121\ncarnival\ncapecod\nfinder\nfearless\ngoats\nfunstuff\ngideon\nsavior\nseabee\nsandro\nschalke\nsalasana\ndisney1\nduckman\npancake\npantera1\nmalice\nlove123\nqwert123\ntracer\ncreation\ncwoui\nnascar24\nhookers\nerection\nericsson\nedthom\nkokoko\nkokomo\nmooses\ninter\n1michael\n1993\n19781978\n25252525\nshibby\nshamus\nskibum\nsheepdog\nsex69\nspliff\nslipper\nspoons\nspanner\nsnowbird\ntoriamos\ntemp123\ntennesse\nlakers1\njomama\nmazdarx7\nrecon\nrevolver\n1025\n1101\nbarney1\nbabycake\ngotham\ngravity\nhallowee\n616161\n515000\ncaca\ncannabis\nchilli\nfdsa\ngetout\nfuck69\ngators1\nsable\nrumble\ndolemite\ndork\nduffer\ndodgers1\nonions\nlogger\nlookout\nmagic32\npoon\ntwat\ncoventry\ncitroen\ncivicsi\ncocksucker\ncoochie\ncompaq1\nnancy1\nbuzzer\nboulder\nbutkus\nbungle\nhogtied\nhotgirls\nheidi1\neggplant\nmustang6\nmonkey12\nwapapapa\nwendy1\nvolleyba\nvibrate\nblink\nbirthday4\nxxxxx1\nstephen1\nsuburban\nsheeba\nstart1\nsoccer10\nstarcraft\nsoccer12\npeanut1\nplastics\npenthous\npeterbil\ntetsuo\ntorino\ntennis1\ntermite\nlemmein\nlakewood\njughead\nmelrose\nmegane\nredone\nangela1\ngoodgirl\ngonzo1\ngolden1\ngotyoass\n656565\n626262\ncapricor\nchains\ncalvin1\ngetmoney\ngabber\nrunaway\nsalami\ndungeon\ndudedude\nopus\nparagon\npanhead\npasadena\nopendoor\nodyssey\nmagellan\nprinting\nprince1\ntrustme\nnono\nbuffet\nhound\nkajak\nkillkill\nmoto\nwinner1\nvixen\nwhiteboy\nversace\nvoyager1\nindy\njackjack\nbigal\nbeech\nbiggun\nblake1\nblue99\nbig1\nsynergy\nsuccess1\n336699\nsixty9\nshark1\nsimba1\nsebring\nspongebo\nspunk\nsprings\nsliver\nphialpha\npassword9\npizza1\npookey\ntickling\nlexingky\nlawman\njoe123\nmike123\nromeo1\nredheads\napple123\nbackbone\naviation\ngreen123\ncarlitos\nbyebye\ncartman1\ncamden\nchewy\ncamaross\nfavorite6\nforumwp\nginscoot\nfruity\nsabrina1\ndevil666\ndoughnut\npantie\noldone\npaintball\nlumina\nrainbow1\nprosper\numbrella\najax\n951753\nachtung\nabc12345\ncompact\ncorndog\ndeerhunt\ndarklord\ndank\nnimitz\nbrandy1\nhetfield\nholein1\nhillbill\nhugetits\nevolutio\nkenobi\nwhiplash\nwg8e3wjf\nistanbul\ninvis\n1996\nbigjohn\nbluebell\nbeater\nbenji\nbluejay\nxyzzy\nsuckdick\ntaichi\nstellar\nshaker\nsemper\nsplurge\nsqueak\npearls\nplayball\npooky\ntitfuck\njoemama\njohnny5\nmarcello\nmaxi\nrhubarb\nratboy\nreload\n1029\n1030\n1220\nbbking\nbaritone\ngryphon\n57chevy\n494949\nceleron\nfishy\ngladiator\nfucker1\nroswell\ndougie\ndicker\ndiva\ndonjuan\nnympho\nracers\ntruck1\ntrample\nacer\ncricket1\nclimax\ndenmark\ncuervo\nnotnow\nnittany\nneutron\nbosco1\nbuffa\nbreaker\nhello2\nhydro\nkisskiss\nkittys\nmontecar\nmodem\nmississi\n20012001\nbigdick1\nbenfica\nyahoo1\nstriper\ntabasco\nsupra\n383838\n456654\nseneca\nshuttle\npenguin1\npathfind\ntestibil\nthethe\njeter2\nmarma\nmark1\nmetoo\nrepublic\nrollin\nredleg\nredbone\nredskin\n1245\nanthony7\naltoids\nbarley\nasswipe\nbauhaus\nbbbbbb1\ngohome\nharrier\ngolfpro\ngoldeney\n818181\n6666666\n5000\n5rxypn\ncameron1\nchecker\ncalibra\nfreefree\nfaith1\nfdm7ed\ngiraffe\ngiggles\nfringe\nscamper\nrrpass1\nscrewyou\ndimples\npacino\nontario\npassthie\noberon\nquest1\npostov1000\npuppydog\npuffer\nqwerty7\ntribal\nadam25\na1234567\ncollie\ncleopatr\ndavide\nnamaste\nbuffalo1\nbonovox\nbukkake\nburner\nbordeaux\nburly\nhun999\nenters\nmohawk\nvgirl\njayden\n1812\n1943\n222333\nbigjim\nbigd\nzoom\nwordup\nziggy1\nyahooo\nworkout\nyoung1\nxmas\nzzzzzz1\nsurfer1\nstrife\nsunlight\ntasha1\nskunk\nsprinter\npeaches1\npinetree\nplum\npimping\ntheforce\nthedon\ntoocool\nladdie\nlkjh\njupiter1\nmatty\nredrose\n1200\n102938\nantares\naustin31\ngoose1\n737373\n78945612\n789987\n6464\ncalimero\ncaster\ncasper1\ncement\nchevrolet\nchessie\ncaddy\ncanucks\nfellatio\nf00tball\ngateway2\ngamecube\nrugby1\nscheisse\ndshade\ndixie1\noffshore\nlucas1\nmacaroni\nmanga\npringles\npuff\ntrouble1\nussy\ncoolhand\ncolonial\ncolt\ndarthvad\ncygnusx1\nnatalie1\nnewark\nhiking\nerrors\nelcamino\nkoolaid\nknight1\nmurphy1\nvolcano\nidunno\n2005\n2233\nblueberr\nbiguns\nyamahar1\nzapper\nzorro1\n0911\n3006\nsixsix\nshopper\nsextoy\nsnowboard\nspeedway\npokey\nplayboy2\ntiti\ntoonarmy\nlambda\njoecool\njuniper\nmax123\nmariposa\nmet2002\nreggae\nricky1\n1236\n1228\n1016\nall4one\nbaberuth\nasgard\n484848\n5683\n6669\ncatnip\ncharisma\ncapslock\ncashmone\ngalant\nfrenchy\ngizmodo1\ngirlies\nscrewy\ndoubled\ndivers\ndte4uw\ndragonfl\ntreble\ntwinkie\ntropical\ncrescent\ncococo\ndabomb\ndaffy\ndandfa\ncyrano\nnathanie\nboners\nhelium\nhellas\nespresso\nkilla\nkikimora\nw4g8at\nilikeit\niforget\n1944\n20002000\nbirthday1\nbeatles1\nblue1\nbigdicks\nbeethove\nblacklab\nblazers\nbenny1\nwoodwork\n0069\n0101\ntaffy\n4567\nshodan\npavlov\npinnacle\npetunia\ntito\nteenie\nlemonade\nlalakers\nlebowski\nlalalala\nladyboy\njeeper\njoyjoy\nmercury1\nmantle\nmannn\nrocknrol\nriversid\n123aaa\n11112222\n121314\n1021\n1004\n1120\nallen1\nambers\namstel\nalice1\nalleycat\nallegro\nambrosia\ngspot\ngoodsex\nhattrick\nharpoon\n878787\n8inches\n4wwvte\ncassandr\ncharlie123\ngatsby\ngeneric\ngareth\nfuckme2\nsamm\nseadog\nsatchmo\nscxakv\nsantafe\ndipper\noutoutout\nmadmad\nlondon1\nqbg26i\npussy123\ntzpvaw\nvamp\ncomp\ncowgirl\ncoldplay\ndawgs\nnt5d27\nnovifarm\nnotredam\nnewness\nmykids\nbryan1\nbouncer\nhihihi\nhoneybee\niceman1\nhotlips\ndynamo\nkappa\nkahlua\nmuffy\nmizzou\nwannabe\nwednesda\nwhatup\nwaterfal\nwilly1\nbear1\nbillabon\nyouknow\nyyyyyy1\nzachary1\n01234567\n070462\nzurich\nsuperstar\nstiletto\nstrat\n427900\nsigmachi\nshells\nsexy123\nsmile1\nsophie1\nstayout\nsomerset\nplaymate\npinkfloyd\nphish1\npayday\nthebear\ntelefon\nlaetitia\nkswbdu\njerky\nmetro\nrevoluti\n1216\n1201\n1204\n1222\n1115\narchange\nbarry1\nhandball\n676767\nchewbacc\nfurball\ngocubs\nfullback\ngman\ndewalt\ndominiqu\ndiver1\ndhip6a\nolemiss\nmandrake\nmangos\npretzel\npusssy\ntripleh\nvagabond\nclovis\ndandan\ncsfbr5yy\ndeadspin\nninguna\nncc74656\nbootsie\nbp2002\nbourbon\nbumble\nheyyou\nhouston1\nhemlock\nhippo\nhornets\nhorseman\nexcess\nextensa\nmuffin1\nvirginie\nwerdna\nidontknow\njack1\n1bitch\n151nxjmt\nbendover\nbmwbmw\nzaq123\nwxcvbn\nsupernov\ntahoe\nshakur\nsexyone\nseviyi\nsmart1\nspeed1\npepito\nphantom1\nplayoffs\nterry1\nterrier\nlaser1\nlite\nlancia\njohngalt\njenjen\nmidori\nmaserati\nmatteo\nmiami1\nriffraff\nronald1\n1218\n1026\n123987\n1015\n1103\narmada\narchitec\naustria\ngotmilk\ncambridg\ncamero\nflex\nforeplay\ngetoff\nglacier\nglotest\nfroggie\ngerbil\nrugger\nsanity72\ndonna1\norchard\noyster\npalmtree\npajero\nm5wkqf\nmagenta\nluckyone\ntreefrog\nvantage\nusmarine\ntyvugq\nuptown\nabacab\naaaaaa1\nchuck1\ndarkange\ncyclones\nnavajo\nbubba123\niawgk2\nhrfzlz\ndylan1\nenrico\nencore\neclipse1\nmutant\nmizuno\nmustang2\nvideo1\nviewer\nweed420\nwhales\njaguar1\n1990\n159159\n1love\nbears1\nbigtruck\nbigboss\nblitz\nxqgann\nyeahyeah\nzeke\nzardoz\nstickman\n3825\nsentra\nshiva\nskipper1\nsingapor\nsouthpaw\nsonora\nsquid\nslamdunk\nslimjim\nplacid\nphoton\nplacebo\npearl1\ntest12\ntherock1\ntiger123\nleinad\nlegman\njeepers\njoeblow\nmike23\nredcar\nrhinos\nrjw7x4\n1102\n13576479\n112211\ngwju3g\ngreywolf\n7bgiqk\n7878\n535353\n4snz9g\ncandyass\ncccccc1\ncatfight\ncali\nfister\nfosters\nfinland\nfrankie1\ngizzmo\nroyalty\nrugrat\ndodo\noemdlg\nout3xf\npaddy\nopennow\npuppy1\nqazwsxedc\nramjet\nabraxas\ncn42qj\ndancer1\ndeath666\nnudity\nnimda2k\nbuick\nbobb\nbraves1\nhenrik\nhooligan\neverlast\nkarachi\nmortis\nmonies\nmotocros\nwally1\nwillie1\ninspiron\n1test\n2929\nbigblack\nxytfu7\nyackwin\nzaq1xsw2\nyy5rbfsc\n100100\n0660\ntahiti\ntakehana\n332211\n3535\nsedona\nseawolf\nskydiver\nspleen\nslash\nspjfet\nspecial1\nslimshad\nsopranos\nspock1\npenis1\npatches1\nthierry\nthething\ntoohot\nlimpone\nmash4077\nmatchbox\nmasterp\nmaxdog\nribbit\nrockin\nredhat\n1113\n14789632\n1331\nallday\naladin\nandrey\namethyst\nbaseball1\nathome\ngoofy1\ngreenman\ngoofball\nha8fyp\ngoodday\n778899\ncharon\nchappy\ncaracas\ncardiff\ncapitals\ncanada1\ncajun\ncatter\nfreddy1\nfavorite2\nforme\nforsaken\nfeelgood\ngfxqx686\nsaskia\nsanjose\nsalsa\ndilbert1\ndukeduke\ndownhill\nlonghair\nlocutus\nlockdown\nmalachi\nmamacita\nlolipop\nrainyday\npumpkin1\npunker\nprospect\nrambo1\nrainbows\nquake\ntrinity1\ntrooper1\ncitation\ncoolcat\ndefault\ndeniro\nd9ungl\ndaddys\nnautica\nnermal\nbukowski\nbubbles1\nbogota\nbuds\nhulk\nhitachi\nender\nexport\nkikiki\nkcchiefs\nkram\nmorticia\nmontrose\nmongo\nwaqw3p\nwizzard\nwhdbtp\nwhkzyc\n154ugeiu\n1fuck\nbinky\nbigred1\nblubber\nbecky1\nyear2005\nwonderfu\nxrated\n0001\ntampabay\nsurvey\ntammy1\nstuffer\n3mpz4r\n3000\n3some\nsierra1\nshampoo\nshyshy\nslapnuts\nstandby\nspartan1\nsprocket\nstanley1\npoker1\ntheshit\nlavalamp\nlight1\nlaserjet\njediknig\njjjjj1\nmazda626\nmenthol\nmargaux\nmedic1\nrhino1\n1209\n1234321\namigos\napricot\nasdfgh1\nhairball\nhatter\ngrimace\n7xm5rq\n6789\ncartoons\ncapcom\ncashflow\ncarrots\nfanatic\nformat\ngirlie\nsafeway\ndogfart\ndondon\noutsider\nodin\nopiate\nlollol\nlove12\nmallrats\nprague\nprimetime21\npugsley\nr29hqq\nvalleywa\nairman\nabcdefg1\ndarkone\ncummer\nnatedogg\nnineball\nndeyl5\nnatchez\nnewone\nnormandy\nnicetits\nbuddy123\nbuddys\nhomely\nhusky\niceland\nhr3ytm\nhighlife\nholla\nearthlin\nexeter\neatmenow\nkimkim\nk2trix\nkernel\nmoney123\nmoonman\nmiles1\nmufasa\nmousey\nwhites\nwarhamme\njackass1\n2277\n20spanks\nblobby\nblinky\nbikers\nblackjack\nbecca\nblue23\nxman\nwyvern\n085tzzqi\nzxzxzx\nzsmj2v\nsuede\nt26gn4\nsugars\ntantra\nswoosh\n4226\n4271\n321123\n383pdjvl\nshane1\nshelby1\nspades\nsmother\nsparhawk\npisser\nphoto1\npebble\npeavey\npavement\nthistle\nkronos\nlilbit\nlinux\nmelanie1\nmarbles\nredlight\n1208\n1138\n1008\nalchemy\naolsucks\nalexalex\natticus\nauditt\nb929ezzh\ngoodyear\ngubber\n863abgsg\n7474\n797979\n464646\n543210\n4zqauf\n4949\nch5nmk\ncarlito\nchewey\ncarebear\ncheckmat\ncheddar\nchachi\nforgetit\nforlife\ngiants1\ngetit\ngerhard\ngalileo\ng3ujwg\nganja\nrufus1\nrushmore\ndiscus\ndudeman\nolympus\noscars\nosprey\nmadcow\nlocust\nloyola\nmammoth\nproton\nrabbit1\nptfe3xxp\npwxd5x\npurple1\npunkass\nprophecy\nuyxnyd\ntyson1\naircraft\naccess99\nabcabc\ncolts\ncivilwar\nclaudia1\ncontour\ndddddd1\ncypher\ndapzu455\ndaisydog\nnoles\nhoochie\nhoser\neldiablo\nkingrich\nmudvayne\nmotown\nmp8o6d\nvipergts\nitaliano\n2055\n2211\nbloke\nblade1\nyamato\nzooropa\nyqlgr667\n050505\nzxcvbnm1\nzw6syj\nsuckcock\ntango1\nswampy\n445566\n333666\n380zliki\nsexpot\nsexylady\nsixtynin\nsickboy\nspiffy\nskylark\nsparkles\npintail\nphreak\nteller\ntimtim\nthighs\nlatex\nletsdoit\nlkjhg\nlandmark\nlizzard\nmarlins\nmarauder\nmetal1\nmanu\nrighton\n1127\nalain\nalcat\namigo\nbasebal1\nazertyui\nazrael\nhamper\ngotenks\ngolfgti\nhawkwind\nh2slca\ngrace1\n6chid8\n789654\ncanine\ncasio\ncazzo\ncbr900\ncabrio\ncalypso\ncapetown\nfeline\nflathead\nfisherma\nflipmode\nfungus\ng9zns4\ngiggle\ngabriel1\nfuck123\nsaffron\ndogmeat\ndreamcas\ndirtydog\ndouche\ndresden\ndickdick\ndestiny1\npappy\noaktree\nluft4\nputa\nramada\ntrumpet1\nvcradq\ntulip\ntracy71\ntycoon\naaaaaaa1\nconquest\nchitown\ncreepers\ncornhole\ndanman\ndada\ndensity\nd9ebk7\ndarth\nnirvana1\nnestle\nbrenda1\nbonanza\nhotspur\nhufmqw\nelectro\nerasure\nelisabet\netvww4\newyuza\neric1\nkenken\nkismet\nklaatu\nmilamber\nwilli\nisacs155\nigor\n1million\n1letmein\nx35v8l\nyogi\nywvxpz\nxngwoj\nzippy1\n020202\n****\nstonewal\nsentry\nsexsexsex\nsonysony\nsmirnoff\nstar12\nsolace\nstar1\npkxe62\npilot1\npommes\npaulpaul\ntical\ntictac\nlighthou\nlemans\nkubrick\nletmein22\nletmesee\njys6wz\njonesy\njjjjjj1\njigga\nredstorm\nriley1\n14141414\n1126\nallison1\nbadboy1\nasthma\nauggie\nhardwood\ngumbo\n616913\n57np39\n56qhxs\n4mnveh\nfatluvr69\nfqkw5m\nfidelity\nfeathers\nfresno\ngodiva\ngecko\ngibson1\ngogators\ngeneral1\nsaxman\nrowing\nsammys\nscotts\nscout1\nsasasa\nsamoht\ndragon69\nducky\ndragonball\ndriller\np3wqaw\npapillon\noneone\nopenit\noptimist\nlongshot\nrapier\npussy2\nralphie\ntuxedo\nundertow\ncopenhag\ndelldell\nculinary\ndeltas\nmytime\nnoname\nnoles1\nbucker\nbopper\nburnout\nibilltes\nhihje863\nhitter\nekim\nespana\neatme69\nelpaso\nexpress1\neeeeee1\neatme1\nkaraoke\nmustang5\nwellingt\nwillem\nwaterski\nwebcam\njasons\ninfinite\niloveyou!\njakarta\nbelair\nbigdad\nbeerme\nyoshi\nyinyang\nx24ik3\n063dyjuy\n0000007\nztmfcq\nstopit\nstooges\nsymow8\nstrato\n2hot4u\nskins\nshakes\nsex1\nsnacks\nsofttail\nslimed123\npizzaman\ntigercat\ntonton\nlager\nlizzy\njuju\njohn123\njesse1\njingles\nmartian\nmario1\nrootedit\nrochard\nredwine\nrequiem\nriverrat\n1117\n1014\n1205\namor\namiga\nalpina\natreides\nbanana1\nbahamut\ngolfman\nhappines\n7uftyx\n5432\n5353\n5151\n4747\nfoxfire\nffvdj474\nforeskin\ngayboy\ngggggg1\ngameover\nglitter\nfunny1\nscoobydoo\nsaxophon\ndingbat\ndigimon\nomicron\npanda1\nloloxx\nmacintos\nlululu\nlollypop\nracer1\nqueen1\nqwertzui\nupnfmc\ntyrant\ntrout1\n9skw5g\naceman\nacls2h\naaabbb\nacapulco\naggie\ncomcast\ncloudy\ncq2kph\nd6o8pm\ncybersex\ndavecole\ndarian\ncrumbs\ndavedave\ndasani\nmzepab\nmyporn\nnarnia\nbooger1\nbravo1\nbudgie\nbtnjey\nhighlander\nhotel6\nhumbug\newtosi\nkristin1\nkobe\nknuckles\nkeith1\nkatarina\nmuff\nmuschi\nmontana1\nwingchun\nwiggle\nwhatthe\nvette1\nvols\nvirago\nintj3a\nishmael\njachin\nillmatic\n199999\n2010\nblender\nbigpenis\nbengal\nblue1234\nzaqxsw\nxray\nxxxxxxx1\nzebras\nyanks\ntadpole\nstripes\n3737\n4343\n3728\n4444444\n368ejhih\nsolar\nsonne\nsniffer\nsonata\nsquirts\nplaystation\npktmxr\npescator\ntexaco\nlesbos\nl8v53x\njo9k2jw2\njimbeam\njimi\njupiter2\njurassic\nmarines1\nrocket1\n14725836\n12345679\n1219\n123098\n1233\nalessand\nalthor\narch\nalpha123\nbasher\nbarefeet\nbalboa\nbbbbb1\nbadabing\ngopack\ngolfnut\ngsxr1000\ngregory1\n766rglqy\n8520\n753159\n8dihc6\n69camaro\n666777\ncheeba\nchino\ncheeky\ncamel1\nfishcake\nflubber\ngianni\ngnasher23\nfrisbee\nfuzzy1\nfuzzball\nsave13tx\nrussell1\nsandra1\nscrotum\nscumbag\nsabre\nsamdog\ndripping\ndragon12\ndragster\norwell\nmainland\nmaine\nqn632o\npoophead\nrapper\nporn4life\nrapunzel\nvelocity\nvanessa1\ntrueblue\nvampire1\nabacus\n902100\ncrispy\nchooch\nd6wnro\ndabulls\ndehpye\nnavyseal\nnjqcw4\nnownow\nnigger1\nnightowl\nnonenone\nnightmar\nbustle\nbuddy2\nboingo\nbugman\nbosshog\nhybrid\nhillside\nhilltop\nhotlegs\nhzze929b\nhhhhh1\nhellohel\nevilone\nedgewise\ne5pftu\neded\nembalmer\nexcalibur\nelefant\nkenzie\nkillah\nkleenex\nmouses\nmounta1n\nmotors\nmutley\nmuffdive\nvivitron\nw00t88\niloveit\njarjar\nincest\nindycar\n17171717\n1664\n17011701\n222777\n2663\nbeelch\nbenben\nyitbos\nyyyyy1\nzzzzz1\nstooge\ntangerin\ntaztaz\nstewart1\nsummer69\nsystem1\nsurveyor\nstirling\n3qvqod\n3way\n456321\nsizzle\nsimhrq\nsparty\nssptx452\nsphere\npersian\nploppy\npn5jvw\npoobear\npianos\nplaster\ntestme\ntiff\nthriller\nmaster12\nrockey\n1229\n1217\n1478\n1009\nanastasi\namonra\nargentin\nalbino\nazazel\ngrinder\n6uldv8\n83y6pv\n8888888\n4tlved\n515051\ncarsten\nflyers88\nffffff1\nfirehawk\nfiredog\nflashman\nggggg1\ngodspeed\ngalway\ngiveitup\nfuntimes\ngohan\ngiveme\ngeryfe\nfrenchie\nsayang\nrudeboy\nsandals\ndougal\ndrag0n\ndga9la\ndesktop\nonlyone\notter\npandas\nmafia\nluckys\nlovelife\nmanders\nqqh92r\nqcmfd454\nradar1\npunani\nptbdhw\nturtles\nundertaker\ntrs8f7\nugejvp\nabba\n911turbo\nacdc\nabcd123\ncrash1\ncolony\ndelboy\ndavinci\nnotebook\nnitrox\nborabora\nbonzai\nbrisbane\nheeled\nhooyah\nhotgirl\ni62gbq\nhorse1\nhpk2qc\nepvjb6\nmnbvc\nmommy1\nmunster\nwiccan\n2369\nbettyboo\nblondy\nbismark\nbeanbag\nbjhgfi\nblackice\nyvtte545\nynot\nyess\nzlzfrh\nwolvie\n007bond\n******\ntailgate\ntanya1\nsxhq65\nstinky1\n3234412\n3ki42x\nseville\nshimmer\nsienna\nshitshit\nskillet\nsooners1\nsolaris\nsmartass\npedros\npennywis\npfloyd\ntobydog\nthetruth\nletme1n\nmario66\nmicky\nrocky2\nrewq\nreindeer\n1128\n1207\n1104\n1432\naprilia\nallstate\nbagels\nbaggies\nbarrage\nguru\n72d5tn\n606060\n4wcqjn\nchance1\nflange\nfartman\ngeil\ngbhcf2\nfussball\nfuaqz4\ngameboy\ngeneviev\nrotary\nseahawk\nsaab\nsamadams\ndevlt4\nditto\ndrevil\ndrinker\ndeuce\ndipstick\noctopus\nottawa\nlosangel\nloverman\nporky\nq9umoz\nrapture\npussy4me\ntriplex\nue8fpw\nturbos\naaa340\nchurchil\ncrazyman\ncutiepie\nddddd1\ndejavu\ncuxldv\nnbvibt\nnikon\nniko\nnascar1\nbubba2\nboobear\nboogers\nbullwink\nbulldawg\nhorsemen\nescalade\neagle2\ndynamic\nefyreg\nminnesot\nmogwai\nmsnxbi\nmwq6qlzo\nwerder\nverygood\nvoodoo1\niiiiii1\n159951\n1624\n1911a1\n2244\nbellagio\nbedlam\nbelkin\nbill1\nxirt2k\n??????\nsusieq\nsundown\nsukebe\nswifty\n2fast4u\nsexe\nshroom\nseaweed\nskeeter1\nsnicker\nspanky1\nspook\nphaedrus\npilots\npeddler\nthumper1\ntiger7\ntmjxn151\nthematri\nl2g7k3\nletmeinn\njeffjeff\njohnmish\nmantra\nmike69\nmazda6\nriptide\nrobots\n1107\n1130\n142857\n11001001\n1134\narmored\nallnight\namatuers\nbartok\nastral\nbaboon\nballs1\nbassoon\nhcleeb\nhappyman\ngranite\ngraywolf\ngolf1\ngomets\n8vjzus\n7890\n789123\n8uiazp\n5757\n474jdvff\n551scasi\n50cent\ncamaro1\ncherry1\nchemist\nfirenze\nfishtank\nfreewill\nglendale\nfrogfrog\nganesh\nscirocco\ndevilman\ndoodles\nokinawa\nolympic\norpheus\nohmygod\npaisley\npallmall\nlunchbox\nmanhatta\nmahalo\nmandarin\nqwqwqw\nqguvyt\npxx3eftp\nrambler\npoppy1\nturk182\nvdlxuc\ntugboat\nvaliant\nuwrl7c\nchris123\ncmfnpu\ndecimal\ndebbie1\ndandy\ndaedalus\nnatasha1\nnissan1\nnancy123\nnevermin\nnapalm\nnewcastle\nbonghit\nibxnsm\nhhhhhh1\nholger\nedmonton\nequinox\ndvader\nkimmy\nknulla\nmustafa\nmonsoon\nmistral\nmorgana\nmonica1\nmojave\nmonterey\nmrbill\nvkaxcs\nvictor1\nviolator\nvfdhif\nwilson1\nwavpzt\nwildstar\nwinter99\niqzzt580\nimback\n1914\n19741974\n1monkey\n2500\n2255\nbigshow\nbigbucks\nblackcoc\nzoomer\nwtcacq\nwobble\nxmen\nxjznq5\nyesterda\nyhwnqc\nzzzxxx\n393939\n2fchbg\nskinhead\nskilled\nshadow12\nseaside\nsinful\nsilicon\nsmk7366\nsnapshot\nsniper1\nsoccer11\nsmutty\npeepers\nplokij\npdiddy\npimpdaddy\nthrust\nterran\ntopaz\ntoday1\nlionhear\nlittlema\nlauren1\nlincoln1\nlgnu9d\njuneau\nmethos\nrogue1\nromulus\nredshift\n1202\n1469\n12locked\narizona1\nalfarome\nal9agd\naol123\naltec\napollo1\narse\nbaker1\nbbb747\naxeman\nastro1\nhawthorn\ngoodfell\nhawks1\ngstring\nhannes\n8543852\n868686\n4ng62t\n554uzpad\n5401\n567890\n5232\ncatfood\nfire1\nflipflop\nfffff1\nfozzie\nfluff\nfzappa\nrustydog\nscarab\nsatin\nruger\nsamsung1\ndestin\ndiablo2\ndreamer1\ndetectiv\ndoqvq3\ndrywall\npaladin1\npapabear\noffroad\npanasonic\nnyyankee\nluetdi\nqcfmtz\npyf8ah\npuddles\npussyeat\nralph1\nprinceto\ntrivia\ntrewq\ntri5a3\nadvent\n9898\nagyvorc\nclarkie\ncoach1\ncourier\nchristo\nchowder\ncyzkhw\ndavidb\ndad2ownu\ndaredevi\nde7mdf\nnazgul\nbooboo1\nbonzo\nbutch1\nhuskers1\nhgfdsa\nhornyman\nelektra\nengland1\nelodie\nkermit1\nkaboom\nmorten\nmocha\nmonday1\nmorgoth\nweewee\nweenie\nvorlon\nwahoo\nilovegod\ninsider\njayman\n1911\n1dallas\n1900\n1ranger\n201jedlz\n2501\n1qaz\nbignuts\nbigbad\nbeebee\nbillows\nbelize\nwvj5np\nwu4etd\nyamaha1\nwrinkle5\nzebra1\nyankee1\nzoomzoom\n09876543\n0311\n?????\nstjabn\ntainted\n3tmnej\nskooter\nskelter\nstarlite\nspice1\nstacey1\nsmithy\npollux\npeternorth\npixie\npiston\npoets\ntoons\ntopspin\nkugm7b\nlegends\njeepjeep\njoystick\njunkmail\njojojojo\njonboy\nmidland\nmayfair\nriches\nreznor\nrockrock\nreboot\nrenee1\nroadway\nrasta220\n1411\n1478963\n1019\narchery\nandyandy\nbarks\nbagpuss\nauckland\ngooseman\nhazmat\ngucci\ngrammy\nhappydog\n7kbe9d\n7676\n6bjvpe\n5lyedn\n5858\n5291\ncharlie2\nc7lrwu\ncandys\nchateau\nccccc1\ncardinals\nfihdfv\nfortune12\ngocats\ngaelic\nfwsadn\ngodboy\ngldmeo\nfx3tuo\nfubar1\ngenerals\ngforce\nrxmtkp\nrulz\nsairam\ndunhill\ndogggg\nozlq6qwm\nov3ajy\nlockout\nmakayla\nmacgyver\nmallorca\nprima\npvjegu\nqhxbij\nprelude1\ntotoro\ntusymo\ntrousers\ntulane\nturtle1\ntracy1\naerosmit\nabbey1\nclticic\ncooper1\ncomets\ndelpiero\ncyprus\ndante1\ndave1\nnounours\nnexus6\nnogard\nnorfolk\nbrent1\nbooyah\nbootleg\nbulls23\nbulls1\nbooper\nheretic\nicecube\nhellno\nhounds\nhoneydew\nhooters1\nhoes\nhevnm4\nhugohugo\nepson\nevangeli\neeeee1\neyphed\n"),
+}
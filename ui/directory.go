@@ -0,0 +1,191 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/aliasgo"
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/golang/protobuf/proto"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	SORT_BY_NAME = "Name"
+	SORT_BY_DATE = "Date"
+)
+
+type AliasEntry struct {
+	Alias     string
+	Timestamp uint64
+	PublicKey []byte
+}
+
+// LoadAliases returns every alias registered on the alias channel.
+// Blocks are read from the cache before the network, so the directory remains available offline.
+func LoadAliases(cache bcgo.Cache, network bcgo.Network) ([]*AliasEntry, error) {
+	aliases := aliasgo.OpenAliasChannel()
+	if err := aliases.Refresh(cache, network); err != nil {
+		// Ignored
+	}
+	var entries []*AliasEntry
+	err := bcgo.Iterate(aliases.Name(), aliases.Head(), nil, cache, network, func(hash []byte, block *bcgo.Block) error {
+		for _, entry := range block.Entry {
+			a := &aliasgo.Alias{}
+			if err := proto.Unmarshal(entry.Record.Payload, a); err != nil {
+				// Skip malformed alias records rather than losing the whole directory
+				log.Println(err)
+				continue
+			}
+			entries = append(entries, &AliasEntry{
+				Alias:     a.Alias,
+				Timestamp: entry.Record.Timestamp,
+				PublicKey: a.PublicKey,
+			})
+		}
+		return nil
+	})
+	return entries, err
+}
+
+type AliasDirectory struct {
+	Search  *widget.Entry
+	Sort    *widget.Select
+	List    *widget.List
+	ui      UI
+	client  bcclientgo.BCClient
+	lock    sync.Mutex
+	entries []*AliasEntry
+	visible []*AliasEntry
+}
+
+func NewAliasDirectory(ui UI, client bcclientgo.BCClient) *AliasDirectory {
+	d := &AliasDirectory{
+		Search: widget.NewEntry(),
+		ui:     ui,
+		client: client,
+	}
+	d.Search.PlaceHolder = "Search"
+	d.Search.Wrapping = fyne.TextWrapOff
+	d.Search.OnChanged = func(string) {
+		d.update()
+	}
+	d.Sort = widget.NewSelect([]string{SORT_BY_NAME, SORT_BY_DATE}, func(string) {
+		d.update()
+	})
+	d.Sort.Selected = SORT_BY_NAME
+	d.List = &widget.List{
+		Length: func() int {
+			d.lock.Lock()
+			defer d.lock.Unlock()
+			return len(d.visible)
+		},
+		CreateItem: func() fyne.CanvasObject {
+			return container.NewGridWithColumns(3,
+				NewAliasLabel(""),
+				NewTimestampLabel(0),
+				&widget.Label{
+					TextStyle: fyne.TextStyle{Monospace: true},
+					Wrapping:  fyne.TextWrapBreak,
+				},
+			)
+		},
+		UpdateItem: func(id widget.ListItemID, item fyne.CanvasObject) {
+			d.lock.Lock()
+			defer d.lock.Unlock()
+			if id < 0 || id >= len(d.visible) {
+				return
+			}
+			e := d.visible[id]
+			objects := item.(*fyne.Container).Objects
+			objects[0].(*AliasLabel).SetAlias(e.Alias)
+			objects[1].(*TimestampLabel).SetTimestamp(e.Timestamp)
+			objects[2].(*widget.Label).SetText(Fingerprint(e.PublicKey))
+		},
+	}
+	d.List.OnSelected = func(id widget.ListItemID) {
+		d.lock.Lock()
+		if id < 0 || id >= len(d.visible) {
+			d.lock.Unlock()
+			return
+		}
+		alias := d.visible[id].Alias
+		d.lock.Unlock()
+		d.List.Unselect(id)
+		go d.ui.ShowURI(d.client, storage.NewAliasURI(alias))
+	}
+	return d
+}
+
+func (d *AliasDirectory) CanvasObject() fyne.CanvasObject {
+	return container.NewBorder(
+		container.NewBorder(nil, nil, nil, d.Sort, d.Search),
+		nil,
+		nil,
+		nil,
+		d.List,
+	)
+}
+
+// Load reads the alias channel and refreshes the list.
+func (d *AliasDirectory) Load() error {
+	cache, err := d.client.Cache()
+	if err != nil {
+		return err
+	}
+	network, err := d.client.Network()
+	if err != nil {
+		return err
+	}
+	entries, err := LoadAliases(cache, network)
+	d.lock.Lock()
+	d.entries = entries
+	d.lock.Unlock()
+	d.update()
+	return err
+}
+
+func (d *AliasDirectory) update() {
+	d.lock.Lock()
+	search := strings.ToLower(d.Search.Text)
+	var visible []*AliasEntry
+	for _, e := range d.entries {
+		if search == "" || strings.Contains(strings.ToLower(e.Alias), search) {
+			visible = append(visible, e)
+		}
+	}
+	switch d.Sort.Selected {
+	case SORT_BY_DATE:
+		sort.Slice(visible, func(i, j int) bool {
+			return visible[i].Timestamp < visible[j].Timestamp
+		})
+	default:
+		sort.Slice(visible, func(i, j int) bool {
+			return strings.ToLower(visible[i].Alias) < strings.ToLower(visible[j].Alias)
+		})
+	}
+	d.visible = visible
+	d.lock.Unlock()
+	d.List.Refresh()
+}
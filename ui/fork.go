@@ -0,0 +1,241 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ForkBlock is a block on one side of a fork.
+type ForkBlock struct {
+	Hash  []byte
+	Block *bcgo.Block
+}
+
+// Fork holds two branches of a channel, each ordered from head back to, but excluding, their common ancestor.
+type Fork struct {
+	Channel  string
+	Ancestor []byte
+	Local    []*ForkBlock
+	Remote   []*ForkBlock
+}
+
+// FindFork walks the local and remote heads back to their common ancestor.
+// It returns nil if either head is an ancestor of the other, as the channel has not diverged.
+func FindFork(cache bcgo.Cache, network bcgo.Network, name string, local, remote []byte) (*Fork, error) {
+	if len(local) == 0 || len(remote) == 0 || bytes.Equal(local, remote) {
+		return nil, nil
+	}
+	load := func(hash []byte) (*ForkBlock, error) {
+		block, err := bcgo.LoadBlock(name, cache, network, hash)
+		if err != nil {
+			return nil, err
+		}
+		return &ForkBlock{
+			Hash:  hash,
+			Block: block,
+		}, nil
+	}
+	l, err := load(local)
+	if err != nil {
+		return nil, err
+	}
+	r, err := load(remote)
+	if err != nil {
+		return nil, err
+	}
+	previous := func(b *ForkBlock) (*ForkBlock, error) {
+		if len(b.Block.Previous) == 0 {
+			return nil, nil
+		}
+		return load(b.Block.Previous)
+	}
+	fork := &Fork{
+		Channel: name,
+	}
+	// Step the longer branch back until both are the same length, then step both until they meet
+	for l != nil && r != nil && !bytes.Equal(l.Hash, r.Hash) {
+		if l.Block.Length >= r.Block.Length {
+			fork.Local = append(fork.Local, l)
+			l, err = previous(l)
+		} else {
+			fork.Remote = append(fork.Remote, r)
+			r, err = previous(r)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if l != nil && r != nil {
+		fork.Ancestor = l.Hash
+	} else {
+		// Branches have different genesis blocks, so walk the rest of each back to its genesis
+		for ; l != nil; l, err = previous(l) {
+			fork.Local = append(fork.Local, l)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for ; r != nil; r, err = previous(r) {
+			fork.Remote = append(fork.Remote, r)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(fork.Local) == 0 || len(fork.Remote) == 0 {
+		// One head descends from the other
+		return nil, nil
+	}
+	return fork, nil
+}
+
+// SetHead replaces the head of the channel with the given block, which may be shorter than the current head.
+func SetHead(cache bcgo.Cache, channel bcgo.Channel, hash []byte, block *bcgo.Block) error {
+	if err := cache.PutBlock(hash, block); err != nil {
+		return err
+	}
+	if err := cache.PutHead(channel.Name(), &bcgo.Reference{
+		Timestamp:   block.Timestamp,
+		ChannelName: channel.Name(),
+		BlockHash:   hash,
+	}); err != nil {
+		return err
+	}
+	channel.Set(block.Timestamp, hash)
+	return nil
+}
+
+// ForkView shows the two branches of a fork side by side, with the longer branch highlighted.
+type ForkView struct {
+	fyne.Container
+	ui     UI
+	client bcclientgo.BCClient
+	// OnChosen is called with the head of the branch chosen by the user.
+	OnChosen func(*ForkBlock)
+}
+
+func NewForkView(ui UI, client bcclientgo.BCClient, fork *Fork) *ForkView {
+	v := &ForkView{
+		ui:     ui,
+		client: client,
+	}
+	var ancestor fyne.CanvasObject = widget.NewLabel("None")
+	if len(fork.Ancestor) > 0 {
+		ancestor = v.link(storage.NewBlockURI(fork.Channel, fork.Ancestor))
+	}
+	v.Layout = layout.NewMaxLayout()
+	v.Objects = []fyne.CanvasObject{
+		container.NewBorder(
+			nil,
+			widget.NewForm(widget.NewFormItem("Common Ancestor", ancestor)),
+			nil,
+			nil,
+			container.NewGridWithColumns(2,
+				v.branch("Local", fork.Channel, fork.Local, len(fork.Local) > len(fork.Remote)),
+				v.branch("Remote", fork.Channel, fork.Remote, len(fork.Remote) > len(fork.Local)),
+			),
+		),
+	}
+	return v
+}
+
+func (v *ForkView) branch(title, name string, blocks []*ForkBlock, longer bool) fyne.CanvasObject {
+	heading := &canvas.Text{
+		Color:    theme.ForegroundColor(),
+		Text:     fmt.Sprintf("%s (%d blocks)", title, len(blocks)),
+		TextSize: theme.TextSize(),
+		TextStyle: fyne.TextStyle{
+			Bold: longer,
+		},
+	}
+	if longer {
+		heading.Color = theme.PrimaryColor()
+		heading.Text += " Longer"
+	}
+	list := NewPageView()
+	list.SetItems(len(blocks), func(i int) fyne.CanvasObject {
+		b := blocks[i]
+		return widget.NewForm(
+			widget.NewFormItem("Hash", v.link(storage.NewBlockURI(name, b.Hash))),
+			widget.NewFormItem("Length", widget.NewLabel(fmt.Sprintf("%d", b.Block.Length))),
+			widget.NewFormItem("Timestamp", NewTimestampLabel(b.Block.Timestamp)),
+			widget.NewFormItem("Miner", NewAliasLabel(b.Block.Miner)),
+		)
+	})
+	keep := widget.NewButton("Keep "+title, func() {
+		if c := v.OnChosen; c != nil && len(blocks) > 0 {
+			c(blocks[0])
+		}
+	})
+	if longer {
+		keep.Importance = widget.HighImportance
+	}
+	return container.NewBorder(heading, keep, nil, nil, container.NewVScroll(list))
+}
+
+func (v *ForkView) link(uri storage.BlockURI) fyne.CanvasObject {
+	l := &Link{
+		Hyperlink: widget.Hyperlink{
+			Text: base64.RawURLEncoding.EncodeToString(uri.BlockHash()),
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+			Wrapping: fyne.TextWrapBreak,
+		},
+		OnTapped: func() {
+			v.ui.ShowURI(v.client, uri)
+		},
+	}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// ShowFork shows the fork in a dialog, and sets the head of the channel to the branch chosen by the user.
+func ShowFork(ui UI, client bcclientgo.BCClient, channel bcgo.Channel, fork *Fork, window fyne.Window, callback func()) {
+	view := NewForkView(ui, client, fork)
+	d := dialog.NewCustom("Fork Detected in "+fork.Channel, "Decide Later", view, window)
+	view.OnChosen = func(head *ForkBlock) {
+		d.Hide()
+		cache, err := client.Cache()
+		if err != nil {
+			ui.ShowError(err)
+			return
+		}
+		if err := SetHead(cache, channel, head.Hash, head.Block); err != nil {
+			ui.ShowError(err)
+			return
+		}
+		if c := callback; c != nil {
+			c()
+		}
+	}
+	d.Show()
+	d.Resize(WindowSize)
+}
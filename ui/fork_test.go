@@ -0,0 +1,112 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/ui"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/cache"
+	"aletheiaware.com/cryptogo"
+	"bytes"
+	"testing"
+)
+
+// makeChain caches a chain of the given length on top of the given block, and returns the hashes from genesis to head.
+func makeChain(t *testing.T, c bcgo.Cache, miner string, previous []byte, length uint64, count int) [][]byte {
+	t.Helper()
+	var hashes [][]byte
+	for i := 0; i < count; i++ {
+		length++
+		block := &bcgo.Block{
+			Timestamp:   bcgo.Timestamp(),
+			ChannelName: "Test",
+			Length:      length,
+			Previous:    previous,
+			Miner:       miner,
+		}
+		hash, err := cryptogo.HashProtobuf(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.PutBlock(hash, block); err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, hash)
+		previous = hash
+	}
+	return hashes
+}
+
+func Test_FindFork(t *testing.T) {
+	c := cache.NewMemory(10)
+	trunk := makeChain(t, c, "Alice", nil, 0, 3)
+	local := makeChain(t, c, "Alice", trunk[2], 3, 2)
+	remote := makeChain(t, c, "Bob", trunk[2], 3, 4)
+	other := makeChain(t, c, "Carol", nil, 0, 3)
+
+	t.Run("Diverged", func(t *testing.T) {
+		fork, err := ui.FindFork(c, nil, "Test", local[1], remote[3])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fork == nil {
+			t.Fatal("Expected fork")
+		}
+		if !bytes.Equal(fork.Ancestor, trunk[2]) {
+			t.Fatal("Incorrect common ancestor")
+		}
+		if len(fork.Local) != 2 || len(fork.Remote) != 4 {
+			t.Fatalf("Incorrect branches; expected 2 and 4, got %d and %d", len(fork.Local), len(fork.Remote))
+		}
+	})
+
+	t.Run("Descendant", func(t *testing.T) {
+		for _, heads := range [][2][]byte{
+			{trunk[1], local[1]},
+			{local[1], trunk[1]},
+			{local[1], local[1]},
+		} {
+			fork, err := ui.FindFork(c, nil, "Test", heads[0], heads[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fork != nil {
+				t.Fatalf("Expected no fork, got %+v", fork)
+			}
+		}
+	})
+
+	t.Run("DifferentGenesis", func(t *testing.T) {
+		fork, err := ui.FindFork(c, nil, "Test", local[1], other[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fork == nil {
+			t.Fatal("Expected fork")
+		}
+		if len(fork.Ancestor) != 0 {
+			t.Fatal("Expected no common ancestor")
+		}
+		// Each branch runs all the way back to its own genesis block
+		if len(fork.Local) != 5 || len(fork.Remote) != 3 {
+			t.Fatalf("Incorrect branches; expected 5 and 3, got %d and %d", len(fork.Local), len(fork.Remote))
+		}
+		if !bytes.Equal(fork.Local[4].Hash, trunk[0]) || !bytes.Equal(fork.Remote[2].Hash, other[0]) {
+			t.Fatal("Branches do not end at genesis")
+		}
+	})
+}
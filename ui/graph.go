@@ -0,0 +1,400 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/software"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"hash/fnv"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+)
+
+const (
+	GRAPH_NODE_RADIUS    = 8
+	GRAPH_COLUMN_SPACING = 200
+	GRAPH_ROW_SPACING    = 60
+)
+
+var _ fyne.Tappable = (*GraphView)(nil)
+var _ fyne.Widget = (*GraphView)(nil)
+
+type GraphNode struct {
+	URI       storage.RecordURI
+	Creator   string
+	Timestamp uint64
+	Depth     int
+	Position  fyne.Position
+}
+
+func (n *GraphNode) Label() string {
+	return n.Creator + " " + bcgo.TimestampToString(n.Timestamp)
+}
+
+type GraphEdge struct {
+	From, To *GraphNode
+}
+
+// Graph holds the records reachable from a root record by following references.
+type Graph struct {
+	Nodes []*GraphNode
+	Edges []*GraphEdge
+	Size  fyne.Size
+}
+
+// LoadGraph expands the references of the given record outward to the given depth.
+func LoadGraph(cache bcgo.Cache, network bcgo.Network, root storage.RecordURI, depth int) (*Graph, error) {
+	g := &Graph{}
+	nodes := make(map[string]*GraphNode)
+	key := func(channel string, record []byte) string {
+		return channel + "/" + base64.RawURLEncoding.EncodeToString(record)
+	}
+	add := func(uri storage.RecordURI, depth int) (*GraphNode, bool) {
+		k := key(uri.Channel(), uri.RecordHash())
+		if n, ok := nodes[k]; ok {
+			return n, false
+		}
+		n := &GraphNode{
+			URI:   uri,
+			Depth: depth,
+		}
+		nodes[k] = n
+		g.Nodes = append(g.Nodes, n)
+		return n, true
+	}
+	first, _ := add(root, 0)
+	queue := []*GraphNode{first}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		record, err := loadRecord(cache, network, n.URI)
+		if err != nil {
+			if n == first {
+				return nil, err
+			}
+			// Leave unreachable records as unlabelled leaves
+			continue
+		}
+		n.Creator = record.Creator
+		n.Timestamp = record.Timestamp
+		if n.Depth >= depth {
+			continue
+		}
+		for _, r := range record.Reference {
+			if len(r.RecordHash) == 0 {
+				continue
+			}
+			m, created := add(storage.NewRecordURI(r.ChannelName, r.BlockHash, r.RecordHash), n.Depth+1)
+			g.Edges = append(g.Edges, &GraphEdge{From: n, To: m})
+			if created {
+				queue = append(queue, m)
+			}
+		}
+	}
+	g.layout()
+	return g, nil
+}
+
+func loadRecord(cache bcgo.Cache, network bcgo.Network, uri storage.RecordURI) (*bcgo.Record, error) {
+	var (
+		block *bcgo.Block
+		err   error
+	)
+	if len(uri.BlockHash()) == 0 {
+		block, err = bcgo.LoadBlockContainingRecord(uri.Channel(), cache, network, uri.RecordHash())
+	} else {
+		block, err = bcgo.LoadBlock(uri.Channel(), cache, network, uri.BlockHash())
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range block.Entry {
+		if bytes.Equal(uri.RecordHash(), entry.RecordHash) {
+			return entry.Record, nil
+		}
+	}
+	return nil, fmt.Errorf("Record not found: %s", uri)
+}
+
+// layout arranges nodes in columns by depth.
+func (g *Graph) layout() {
+	rows := make(map[int]int)
+	var columns, height int
+	for _, n := range g.Nodes {
+		row := rows[n.Depth]
+		rows[n.Depth] = row + 1
+		n.Position = fyne.NewPos(
+			float32(n.Depth*GRAPH_COLUMN_SPACING+GRAPH_COLUMN_SPACING/2),
+			float32(row*GRAPH_ROW_SPACING+GRAPH_ROW_SPACING/2),
+		)
+		if n.Depth+1 > columns {
+			columns = n.Depth + 1
+		}
+		if row+1 > height {
+			height = row + 1
+		}
+	}
+	g.Size = fyne.NewSize(float32(columns*GRAPH_COLUMN_SPACING), float32(height*GRAPH_ROW_SPACING))
+}
+
+// NodeAt returns the node drawn at the given position, or nil.
+func (g *Graph) NodeAt(p fyne.Position) *GraphNode {
+	for _, n := range g.Nodes {
+		dx := float64(p.X - n.Position.X)
+		dy := float64(p.Y - n.Position.Y)
+		if math.Sqrt(dx*dx+dy*dy) <= GRAPH_NODE_RADIUS*2 {
+			return n
+		}
+	}
+	return nil
+}
+
+// WriteSVG writes the graph as a Scalable Vector Graphic.
+func (g *Graph) WriteSVG(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "<svg version=\"1.1\" xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">\n", int(g.Size.Width), int(g.Size.Height)); err != nil {
+		return err
+	}
+	for _, e := range g.Edges {
+		if _, err := fmt.Fprintf(w, "    <line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"gray\" />\n", int(e.From.Position.X), int(e.From.Position.Y), int(e.To.Position.X), int(e.To.Position.Y)); err != nil {
+			return err
+		}
+	}
+	for _, n := range g.Nodes {
+		r, gr, b, _ := ChannelColor(n.URI.Channel()).RGBA()
+		if _, err := fmt.Fprintf(w, "    <a href=\"%s\"><circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"#%02x%02x%02x\" /></a>\n", html.EscapeString(n.URI.String()), int(n.Position.X), int(n.Position.Y), GRAPH_NODE_RADIUS, r>>8, gr>>8, b>>8); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "    <text x=\"%d\" y=\"%d\" text-anchor=\"middle\" font-family=\"monospace\" font-size=\"10\">%s</text>\n", int(n.Position.X), int(n.Position.Y)+GRAPH_NODE_RADIUS*3, html.EscapeString(n.Label())); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "</svg>")
+	return err
+}
+
+// Image draws the whole graph at its full size, regardless of how much of it is visible in a window.
+func (g *Graph) Image() image.Image {
+	v := &GraphView{
+		graph: g,
+	}
+	v.ExtendBaseWidget(v)
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetContent(v)
+	c.Resize(g.Size)
+	return c.Capture()
+}
+
+// ChannelColor returns a color derived from the channel name, so every node of a channel has the same color.
+func ChannelColor(channel string) color.Color {
+	h := fnv.New32a()
+	h.Write([]byte(channel))
+	hue := float64(h.Sum32()%360) / 60
+	x := uint8(255 * (1 - math.Abs(math.Mod(hue, 2)-1)))
+	switch int(hue) {
+	case 0:
+		return color.NRGBA{R: 255, G: x, A: 255}
+	case 1:
+		return color.NRGBA{R: x, G: 255, A: 255}
+	case 2:
+		return color.NRGBA{G: 255, B: x, A: 255}
+	case 3:
+		return color.NRGBA{G: x, B: 255, A: 255}
+	case 4:
+		return color.NRGBA{R: x, B: 255, A: 255}
+	default:
+		return color.NRGBA{R: 255, B: x, A: 255}
+	}
+}
+
+type GraphView struct {
+	widget.BaseWidget
+	ui     UI
+	client bcclientgo.BCClient
+	lock   sync.RWMutex
+	graph  *Graph
+}
+
+func NewGraphView(ui UI, client bcclientgo.BCClient) *GraphView {
+	v := &GraphView{
+		ui:     ui,
+		client: client,
+		graph:  &Graph{},
+	}
+	v.ExtendBaseWidget(v)
+	return v
+}
+
+func (v *GraphView) Graph() *Graph {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	return v.graph
+}
+
+func (v *GraphView) SetURI(uri storage.RecordURI, depth int) error {
+	cache, err := v.client.Cache()
+	if err != nil {
+		return err
+	}
+	network, err := v.client.Network()
+	if err != nil {
+		return err
+	}
+	graph, err := LoadGraph(cache, network, uri, depth)
+	if err != nil {
+		return err
+	}
+	v.lock.Lock()
+	v.graph = graph
+	v.lock.Unlock()
+	v.Refresh()
+	return nil
+}
+
+func (v *GraphView) CreateRenderer() fyne.WidgetRenderer {
+	r := &graphRenderer{
+		view: v,
+	}
+	r.Refresh()
+	return r
+}
+
+func (v *GraphView) Tapped(e *fyne.PointEvent) {
+	if n := v.Graph().NodeAt(e.Position); n != nil {
+		go v.ui.ShowURI(v.client, n.URI)
+	}
+}
+
+var _ fyne.WidgetRenderer = (*graphRenderer)(nil)
+
+type graphRenderer struct {
+	view    *GraphView
+	objects []fyne.CanvasObject
+}
+
+func (r *graphRenderer) Destroy() {
+}
+
+func (r *graphRenderer) Layout(size fyne.Size) {
+}
+
+func (r *graphRenderer) MinSize() fyne.Size {
+	return r.view.Graph().Size
+}
+
+func (r *graphRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *graphRenderer) Refresh() {
+	g := r.view.Graph()
+	var objects []fyne.CanvasObject
+	for _, e := range g.Edges {
+		l := canvas.NewLine(theme.DisabledColor())
+		l.Position1 = e.From.Position
+		l.Position2 = e.To.Position
+		objects = append(objects, l)
+	}
+	for _, n := range g.Nodes {
+		c := canvas.NewCircle(ChannelColor(n.URI.Channel()))
+		c.Move(n.Position.Subtract(fyne.NewPos(GRAPH_NODE_RADIUS, GRAPH_NODE_RADIUS)))
+		c.Resize(fyne.NewSize(GRAPH_NODE_RADIUS*2, GRAPH_NODE_RADIUS*2))
+		t := canvas.NewText(n.Label(), theme.ForegroundColor())
+		t.TextSize = theme.CaptionTextSize()
+		t.TextStyle = fyne.TextStyle{Monospace: true}
+		t.Alignment = fyne.TextAlignCenter
+		t.Move(fyne.NewPos(n.Position.X-GRAPH_COLUMN_SPACING/2, n.Position.Y+GRAPH_NODE_RADIUS))
+		t.Resize(fyne.NewSize(GRAPH_COLUMN_SPACING, t.MinSize().Height))
+		objects = append(objects, c, t)
+	}
+	r.objects = objects
+	canvas.Refresh(r.view)
+}
+
+// ShowGraph opens a window showing the reference graph rooted at the given record, which can be exported as PNG or SVG.
+func ShowGraph(ui UI, client bcclientgo.BCClient, uri storage.RecordURI) {
+	window := fyne.CurrentApp().NewWindow("Graph " + uri.Name())
+	graph := NewGraphView(ui, client)
+	depth := widget.NewSelect([]string{"1", "2", "3", "4", "5"}, func(s string) {
+		d, err := strconv.Atoi(s)
+		if err != nil {
+			ui.ShowError(err)
+			return
+		}
+		go func() {
+			if err := graph.SetURI(uri, d); err != nil {
+				ui.ShowError(err)
+			}
+		}()
+	})
+	export := func(extension string, writer func(io.Writer) error) {
+		d := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			if w == nil {
+				return
+			}
+			defer w.Close()
+			if err := writer(w); err != nil {
+				ui.ShowError(err)
+			}
+		}, window)
+		d.SetFileName(uri.Name() + extension)
+		d.Show()
+	}
+	window.SetContent(container.NewBorder(
+		container.NewHBox(
+			widget.NewLabel("Depth"),
+			depth,
+			widget.NewButton("Export PNG", func() {
+				export(".png", func(w io.Writer) error {
+					return png.Encode(w, graph.Graph().Image())
+				})
+			}),
+			widget.NewButton("Export SVG", func() {
+				export(".svg", graph.Graph().WriteSVG)
+			}),
+		),
+		nil,
+		nil,
+		nil,
+		container.NewScroll(graph),
+	))
+	window.Resize(WindowSize)
+	window.CenterOnScreen()
+	window.Show()
+	depth.SetSelected("2")
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcfynego/ui"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"testing"
+)
+
+func Test_GraphImage(t *testing.T) {
+	test.NewApp()
+	from := &ui.GraphNode{
+		URI:      storage.NewRecordURI("A", []byte{1}, []byte{2}),
+		Creator:  "Alice",
+		Position: fyne.NewPos(ui.GRAPH_COLUMN_SPACING/2, ui.GRAPH_ROW_SPACING/2),
+	}
+	to := &ui.GraphNode{
+		URI:      storage.NewRecordURI("B", []byte{3}, []byte{4}),
+		Creator:  "Bob",
+		Depth:    4,
+		Position: fyne.NewPos(ui.GRAPH_COLUMN_SPACING*4+ui.GRAPH_COLUMN_SPACING/2, ui.GRAPH_ROW_SPACING*9+ui.GRAPH_ROW_SPACING/2),
+	}
+	g := &ui.Graph{
+		Nodes: []*ui.GraphNode{from, to},
+		Edges: []*ui.GraphEdge{{From: from, To: to}},
+		Size:  fyne.NewSize(ui.GRAPH_COLUMN_SPACING*5, ui.GRAPH_ROW_SPACING*10),
+	}
+	// Larger than the window, so a capture of the window would be cropped
+	if g.Size.Width <= ui.WindowSize.Width && g.Size.Height <= ui.WindowSize.Height {
+		t.Fatalf("Graph fits in window: %v", g.Size)
+	}
+	bounds := g.Image().Bounds()
+	if bounds.Dx() != int(g.Size.Width) || bounds.Dy() != int(g.Size.Height) {
+		t.Fatalf("Incorrect image size; expected %v, got %v", g.Size, bounds.Size())
+	}
+}
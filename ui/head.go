@@ -22,6 +22,7 @@ import (
 	"aletheiaware.com/bcgo"
 	"aletheiaware.com/bcgo/channel"
 	"encoding/base64"
+	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
@@ -35,6 +36,7 @@ type HeadView struct {
 	channel   *widget.Label
 	hash      *Link
 	timestamp *widget.Label
+	watch     *widget.Check
 }
 
 func NewHeadView(ui UI, client bcclientgo.BCClient) *HeadView {
@@ -62,6 +64,14 @@ func NewHeadView(ui UI, client bcclientgo.BCClient) *HeadView {
 			Wrapping: fyne.TextWrapBreak,
 		},
 	}
+	v.watch = widget.NewCheck("Sync in Background", func(checked bool) {
+		preferences := fyne.CurrentApp().Preferences()
+		if checked {
+			storage.Watch(preferences, v.channel.Text)
+		} else {
+			storage.Unwatch(preferences, v.channel.Text)
+		}
+	})
 	v.ExtendBaseWidget(v)
 	v.channel.ExtendBaseWidget(v.channel)
 	v.hash.ExtendBaseWidget(v.hash)
@@ -69,6 +79,7 @@ func NewHeadView(ui UI, client bcclientgo.BCClient) *HeadView {
 	v.Append("Channel", v.channel)
 	v.Append("Hash", v.hash)
 	v.Append("Timestamp", v.timestamp)
+	v.Append("Watch", v.watch)
 	v.Append("", container.NewGridWithColumns(2,
 		widget.NewButton("Pull", func() {
 			cache, err := v.client.Cache()
@@ -81,35 +92,53 @@ func NewHeadView(ui UI, client bcclientgo.BCClient) *HeadView {
 				v.ui.ShowError(err)
 				return
 			}
-			channel := channel.New(v.channel.Text)
-			if err := channel.Pull(cache, network); err != nil {
-				v.ui.ShowError(err)
-				return
+			name := v.channel.Text
+			channel := channel.New(name)
+			if err := channel.Load(cache, nil); err != nil {
+				// Ignored
 			}
+			local := channel.Head()
+			ShowSync(v.ui, v.client, name, false, WindowForObject(v), func(results []*PeerResult) {
+				if err := channel.Load(cache, nil); err != nil {
+					// Ignored
+				}
+				go UpdateChannelReferences(v.ui, v.client, name)
+				UpdateInbox(v.ui, v.client, name)
+				// Shorter remote chains are rejected by Pull, so check each peer for divergence
+				for _, r := range results {
+					fork, err := FindFork(cache, network, name, local, r.RemoteHead)
+					if err != nil {
+						v.ui.ShowError(fmt.Errorf("Could not compare with %s: %s", r.Peer, err))
+						continue
+					}
+					if fork != nil {
+						ShowFork(v.ui, v.client, channel, fork, WindowForObject(v), func() {
+							v.SetURI(storage.NewChannelURI(name))
+						})
+						break
+					}
+				}
+				v.SetURI(storage.NewChannelURI(name))
+			})
 		}),
 		widget.NewButton("Push", func() {
-			cache, err := v.client.Cache()
-			if err != nil {
-				v.ui.ShowError(err)
-				return
-			}
-			network, err := v.client.Network()
-			if err != nil {
-				v.ui.ShowError(err)
-				return
-			}
-			channel := channel.New(v.channel.Text)
-			if err := channel.Push(cache, network); err != nil {
-				v.ui.ShowError(err)
-				return
-			}
+			name := v.channel.Text
+			ShowSync(v.ui, v.client, name, true, WindowForObject(v), func([]*PeerResult) {
+				v.SetURI(storage.NewChannelURI(name))
+			})
 		}),
 		widget.NewButton("Write", func() {
-			log.Println("// TODO go c.Write()")
+			ShowComposer(v.ui, v.client, v.channel.Text, WindowForObject(v))
 		}),
 		widget.NewButton("Mine", func() {
 			log.Println("// TODO go c.Mine()")
 		}),
+		widget.NewButton("Notifications", func() {
+			ShowNotificationRule(v.channel.Text, WindowForObject(v))
+		}),
+		widget.NewButton("Audit", func() {
+			ShowAuditOptions(v.ui, v.client, v.channel.Text, WindowForObject(v))
+		}),
 	))
 	return v
 }
@@ -129,11 +158,13 @@ func (v *HeadView) SetURI(uri storage.ChannelURI) error {
 	if err := channel.Refresh(cache, network); err != nil {
 		// Ignored
 	}
+	go UpdateChannelReferences(v.ui, v.client, name)
 	v.hash.SetText(base64.RawURLEncoding.EncodeToString(channel.Head()))
 	v.hash.OnTapped = func() {
 		v.ui.ShowURI(v.client, storage.NewBlockURI(name, channel.Head()))
 	}
 	v.channel.SetText(name)
+	v.watch.SetChecked(storage.IsWatched(fyne.CurrentApp().Preferences(), name))
 	v.timestamp.SetText(bcgo.TimestampToString(channel.Timestamp()))
 	v.Refresh()
 	return nil
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"log"
+	"unicode/utf8"
+)
+
+// PREVIEW_LENGTH is the number of characters of a decrypted payload shown in the inbox.
+const PREVIEW_LENGTH = 100
+
+// UpdateInbox scans the cached blocks of the given channel for records shared with the signed-in account.
+// Nothing is done when no account is signed in.
+func UpdateInbox(ui UI, client bcclientgo.BCClient, name string) {
+	if !client.HasAccount() {
+		return
+	}
+	inbox, err := ui.Inbox(client)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	cache, err := client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	head, err := cache.Head(name)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err := inbox.Update(name, head.BlockHash, cache, nil); err != nil {
+		log.Println(err)
+	}
+}
+
+// ScanInbox updates the inbox from every channel known to the reference index, the watched channels, and those already scanned.
+func ScanInbox(ui UI, client bcclientgo.BCClient) {
+	channels := make(map[string]bool)
+	if index, err := ui.ReferenceIndex(client); err != nil {
+		log.Println(err)
+	} else {
+		for c := range index.Channels() {
+			channels[c] = true
+		}
+	}
+	if inbox, err := ui.Inbox(client); err != nil {
+		log.Println(err)
+	} else {
+		for c := range inbox.Channels() {
+			channels[c] = true
+		}
+	}
+	for _, c := range storage.WatchedChannels(fyne.CurrentApp().Preferences()) {
+		channels[c] = true
+	}
+	for c := range channels {
+		UpdateInbox(ui, client, c)
+	}
+}
+
+// Preview returns the start of the decrypted payload of the given record, or a description if it is not text.
+// The previous keys of the account are tried if the record was shared before the key was rotated.
+func Preview(cache bcgo.Cache, account bcgo.Account, previous []bcgo.Account, item *storage.InboxItem) (string, error) {
+	block, err := bcgo.LoadBlock(item.Channel, cache, nil, item.BlockHash)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range block.Entry {
+		if !bytes.Equal(entry.RecordHash, item.RecordHash) {
+			continue
+		}
+		access := CanDecrypt(account, entry.Record)
+		if access == nil {
+			return "", ErrNoAccess
+		}
+		var preview string
+		if err := Decrypt(account, previous, entry, access, func(e *bcgo.BlockEntry, key, payload []byte) error {
+			payload, err := storage.Decompress(e.Record, payload)
+			if err != nil {
+				return err
+			}
+			if !utf8.Valid(payload) {
+				preview = fmt.Sprintf("%d bytes", len(payload))
+				return nil
+			}
+			runes := []rune(string(payload))
+			if len(runes) > PREVIEW_LENGTH {
+				preview = string(runes[:PREVIEW_LENGTH]) + "…"
+			} else {
+				preview = string(runes)
+			}
+			return nil
+		}); err != nil {
+			return "", err
+		}
+		return preview, nil
+	}
+	return "", fmt.Errorf("Record not found: %s", item.URI())
+}
+
+// InboxView lists the records shared with the signed-in account, newest first.
+type InboxView struct {
+	fyne.Container
+	ui     UI
+	client bcclientgo.BCClient
+	unread *widget.Label
+	items  *PageView
+}
+
+func NewInboxView(ui UI, client bcclientgo.BCClient) *InboxView {
+	v := &InboxView{
+		ui:     ui,
+		client: client,
+		unread: widget.NewLabel(""),
+		items:  NewPageView(),
+	}
+	v.Layout = layout.NewVBoxLayout()
+	v.Objects = []fyne.CanvasObject{
+		container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ViewRefreshIcon(), func() {
+			go v.Scan()
+		}), v.unread),
+		v.items,
+	}
+	return v
+}
+
+// Scan updates the inbox from the cached channels and then refreshes the view.
+func (v *InboxView) Scan() {
+	v.unread.SetText("Scanning")
+	ScanInbox(v.ui, v.client)
+	v.Update()
+}
+
+// Update refreshes the view from the inbox.
+func (v *InboxView) Update() {
+	inbox, err := v.ui.Inbox(v.client)
+	if err != nil {
+		v.ui.ShowError(err)
+		return
+	}
+	cache, err := v.client.Cache()
+	if err != nil {
+		v.ui.ShowError(err)
+		return
+	}
+	account, err := v.client.Account()
+	if err != nil {
+		v.ui.ShowError(err)
+		return
+	}
+	previous := v.ui.PreviousAccounts(v.client)
+	items := inbox.Items()
+	v.unread.SetText(fmt.Sprintf("%d unread of %d", inbox.Unread(), len(items)))
+	// Previews are kept so paging back and forth does not decrypt the same record again
+	previews := make(map[int]string)
+	v.items.SetItemsPage(len(items), func(i int) fyne.CanvasObject {
+		item := items[i]
+		preview, ok := previews[i]
+		if !ok {
+			p, err := Preview(cache, account, previous, item)
+			if err != nil {
+				p = err.Error()
+			}
+			preview = p
+			previews[i] = p
+		}
+		title := widget.NewLabelWithStyle(fmt.Sprintf("%s - %s", item.Creator, item.Channel), fyne.TextAlignLeading, fyne.TextStyle{Bold: !item.Read})
+		toggle := "Mark Read"
+		if item.Read {
+			toggle = "Mark Unread"
+		}
+		return container.NewBorder(
+			container.NewBorder(nil, nil, nil, NewTimestampLabel(item.Timestamp), title),
+			nil,
+			nil,
+			container.NewVBox(
+				widget.NewButton("Open", func() {
+					if err := inbox.SetRead(item.RecordHash, true); err != nil {
+						log.Println(err)
+					}
+					v.Update()
+					go v.ui.ShowURI(v.client, item.URI())
+				}),
+				widget.NewButton(toggle, func() {
+					if err := inbox.SetRead(item.RecordHash, !item.Read); err != nil {
+						v.ui.ShowError(err)
+					}
+					v.Update()
+				}),
+			),
+			&widget.Label{
+				Text:     preview,
+				Wrapping: fyne.TextWrapWord,
+			},
+		)
+	}, v.items.page)
+}
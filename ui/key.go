@@ -17,18 +17,79 @@
 package ui
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"github.com/skip2/go-qrcode"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"strings"
 )
 
+const (
+	IDENTICON_CELLS = 5
+	IDENTICON_SIZE  = 40
+	QR_CODE_SIZE    = 256
+)
+
+// Fingerprint returns the SHA-256 hash of the given key in groups of four hex digits.
+func Fingerprint(key []byte) string {
+	hash := sha256.Sum256(key)
+	return FormatFingerprint(hash[:])
+}
+
+// FormatFingerprint returns the given hash in groups of four hex digits for reading aloud.
+func FormatFingerprint(hash []byte) string {
+	digits := hex.EncodeToString(hash)
+	var groups []string
+	for i := 0; i < len(digits); i += 4 {
+		groups = append(groups, digits[i:i+4])
+	}
+	return strings.ToUpper(strings.Join(groups, " "))
+}
+
+// Identicon returns a horizontally symmetric image derived from the SHA-256 hash of the given key.
+func Identicon(key []byte) image.Image {
+	hash := sha256.Sum256(key)
+	foreground := color.NRGBA{R: hash[0], G: hash[1], B: hash[2], A: 255}
+	cell := IDENTICON_SIZE / IDENTICON_CELLS
+	img := image.NewNRGBA(image.Rect(0, 0, IDENTICON_SIZE, IDENTICON_SIZE))
+	for y := 0; y < IDENTICON_CELLS; y++ {
+		for x := 0; x < (IDENTICON_CELLS+1)/2; x++ {
+			bit := y*IDENTICON_CELLS + x
+			if hash[3+bit/8]&(1<<uint(bit%8)) == 0 {
+				continue
+			}
+			for _, column := range []int{x, IDENTICON_CELLS - 1 - x} {
+				for dy := 0; dy < cell; dy++ {
+					for dx := 0; dx < cell; dx++ {
+						img.Set(column*cell+dx, y*cell+dy, foreground)
+					}
+				}
+			}
+		}
+	}
+	return img
+}
+
+// KeyLabel shows the fingerprint and identicon of a key, with the full key and its QR code available on expansion.
+// The text of the label remains the base64 encoding of the key.
 type KeyLabel struct {
 	widget.Label
+	key []byte
 }
 
 func NewKeyLabel(key []byte) *KeyLabel {
 	k := &KeyLabel{
-		widget.Label{
+		Label: widget.Label{
 			Alignment: fyne.TextAlignLeading,
 			TextStyle: fyne.TextStyle{Monospace: true},
 			Wrapping:  fyne.TextWrapBreak,
@@ -39,6 +100,144 @@ func NewKeyLabel(key []byte) *KeyLabel {
 	return k
 }
 
+func (k *KeyLabel) CreateRenderer() fyne.WidgetRenderer {
+	r := &keyLabelRenderer{
+		label: k,
+		identicon: &canvas.Image{
+			FillMode: canvas.ImageFillContain,
+		},
+		fingerprint: &widget.Label{
+			Alignment: k.Alignment,
+			TextStyle: k.TextStyle,
+			Wrapping:  k.Wrapping,
+		},
+		encoded: &widget.Label{
+			Alignment: k.Alignment,
+			TextStyle: k.TextStyle,
+			Wrapping:  k.Wrapping,
+		},
+		large: &canvas.Image{
+			FillMode:  canvas.ImageFillContain,
+			ScaleMode: canvas.ImageScalePixels,
+		},
+		qr: &canvas.Image{
+			FillMode: canvas.ImageFillContain,
+		},
+	}
+	r.identicon.SetMinSize(fyne.NewSize(IDENTICON_SIZE, IDENTICON_SIZE))
+	r.large.SetMinSize(fyne.NewSize(IDENTICON_SIZE*2, IDENTICON_SIZE*2))
+	r.qr.SetMinSize(fyne.NewSize(QR_CODE_SIZE, QR_CODE_SIZE))
+	r.content = container.NewVBox(
+		container.NewBorder(nil, nil, r.identicon, widget.NewButtonWithIcon("", theme.ContentCopyIcon(), func() {
+			CopyToClipboard(k, r.fingerprint.Text)
+		}), r.fingerprint),
+		widget.NewAccordion(
+			widget.NewAccordionItem("Key", container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("", theme.ContentCopyIcon(), func() {
+				CopyToClipboard(k, k.Text)
+			}), r.encoded)),
+			widget.NewAccordionItem("Identicon", container.NewVBox(
+				container.NewCenter(r.large),
+				widget.NewButtonWithIcon("Copy Identicon", theme.ContentCopyIcon(), func() {
+					copyImageToClipboard(k, r.identicon.Image)
+				}),
+			)),
+			widget.NewAccordionItem("QR Code", container.NewVBox(
+				container.NewCenter(r.qr),
+				widget.NewButtonWithIcon("Copy QR Code", theme.ContentCopyIcon(), func() {
+					copyImageToClipboard(k, r.qr.Image)
+				}),
+			)),
+		),
+	)
+	r.Refresh()
+	return r
+}
+
+func (k *KeyLabel) Key() []byte {
+	return k.key
+}
+
 func (k *KeyLabel) SetKey(key []byte) {
+	k.key = key
 	k.SetText(base64.RawURLEncoding.EncodeToString(key))
 }
+
+// SetText sets the text of the label, which is expected to be a base64 encoded key.
+func (k *KeyLabel) SetText(text string) {
+	k.Text = text
+	if key, err := base64.RawURLEncoding.DecodeString(text); err == nil {
+		k.key = key
+	} else {
+		k.key = nil
+	}
+	k.Refresh()
+}
+
+func (k *KeyLabel) Refresh() {
+	// The embedded label is drawn by keyLabelRenderer, not by its own renderer
+	k.BaseWidget.Refresh()
+}
+
+// copyImageToClipboard copies the given image as a PNG data URI, as the clipboard only holds text.
+func copyImageToClipboard(o fyne.CanvasObject, img image.Image) {
+	if img == nil {
+		return
+	}
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		log.Println(err)
+		return
+	}
+	CopyToClipboard(o, "data:image/png;base64,"+base64.StdEncoding.EncodeToString(buffer.Bytes()))
+}
+
+var _ fyne.WidgetRenderer = (*keyLabelRenderer)(nil)
+
+type keyLabelRenderer struct {
+	label       *KeyLabel
+	identicon   *canvas.Image
+	large       *canvas.Image
+	fingerprint *widget.Label
+	encoded     *widget.Label
+	qr          *canvas.Image
+	content     *fyne.Container
+}
+
+func (r *keyLabelRenderer) Destroy() {
+}
+
+func (r *keyLabelRenderer) Layout(size fyne.Size) {
+	r.content.Resize(size)
+}
+
+func (r *keyLabelRenderer) MinSize() fyne.Size {
+	return r.content.MinSize()
+}
+
+func (r *keyLabelRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.content}
+}
+
+func (r *keyLabelRenderer) Refresh() {
+	key := r.label.key
+	r.encoded.SetText(r.label.Text)
+	if len(key) == 0 {
+		r.fingerprint.SetText("")
+		r.identicon.Image = nil
+		r.qr.Image = nil
+	} else {
+		r.fingerprint.SetText(Fingerprint(key))
+		r.identicon.Image = Identicon(key)
+		if q, err := qrcode.New(r.label.Text, qrcode.Medium); err != nil {
+			log.Println(err)
+			r.qr.Image = nil
+		} else {
+			r.qr.Image = q.Image(QR_CODE_SIZE)
+		}
+	}
+	r.large.Image = r.identicon.Image
+	r.identicon.Refresh()
+	r.large.Refresh()
+	r.qr.Refresh()
+	canvas.Refresh(r.label)
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/ui"
+	"bytes"
+	"encoding/base64"
+	"fyne.io/fyne/v2/test"
+	"testing"
+)
+
+func Test_KeyLabel(t *testing.T) {
+	test.NewApp()
+	key := []byte("public key")
+	label := ui.NewKeyLabel(key)
+	w := test.NewWindow(label)
+	defer w.Close()
+	if want := base64.RawURLEncoding.EncodeToString(key); label.Text != want {
+		t.Fatalf("Incorrect text; expected '%s', got '%s'", want, label.Text)
+	}
+	other := []byte("other key")
+	label.SetText(base64.RawURLEncoding.EncodeToString(other))
+	if !bytes.Equal(label.Key(), other) {
+		t.Fatalf("Incorrect key; expected '%s', got '%s'", other, label.Key())
+	}
+	label.SetKey(nil)
+	if label.Text != "" || len(label.Key()) != 0 {
+		t.Fatalf("Expected empty label, got '%s'", label.Text)
+	}
+}
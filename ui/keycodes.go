@@ -0,0 +1,111 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/makiuchi-d/gozxing"
+	zxingqrcode "github.com/makiuchi-d/gozxing/qrcode"
+	"github.com/skip2/go-qrcode"
+	"image"
+)
+
+// QR_CODE_MODULE_SIZE is the number of pixels on each side of a module, kept whole so saved codes can be read back with DecodeQRCode.
+const QR_CODE_MODULE_SIZE = 4
+
+// DecodeQRCode returns the text held in the QR code found in the image, such as a scanned or photographed code.
+func DecodeQRCode(img image.Image) (string, error) {
+	bitmap, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return "", err
+	}
+	result, err := zxingqrcode.NewQRCodeReader().Decode(bitmap, nil)
+	if err != nil {
+		return "", err
+	}
+	return result.GetText(), nil
+}
+
+// KeyCodes shows the QR codes of a key transferred offline one at a time.
+type KeyCodes struct {
+	fyne.Container
+	Images   []image.Image
+	index    int
+	qr       *canvas.Image
+	label    *widget.Label
+	previous *widget.Button
+	next     *widget.Button
+}
+
+func NewKeyCodes(parts []string) (*KeyCodes, error) {
+	k := &KeyCodes{
+		qr: &canvas.Image{
+			FillMode: canvas.ImageFillContain,
+		},
+		label: &widget.Label{
+			Alignment: fyne.TextAlignCenter,
+		},
+	}
+	for _, p := range parts {
+		q, err := qrcode.New(p, qrcode.Medium)
+		if err != nil {
+			return nil, err
+		}
+		k.Images = append(k.Images, q.Image(-QR_CODE_MODULE_SIZE))
+	}
+	k.qr.SetMinSize(fyne.NewSize(QR_CODE_SIZE, QR_CODE_SIZE))
+	k.previous = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		k.SetIndex(k.index - 1)
+	})
+	k.next = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		k.SetIndex(k.index + 1)
+	})
+	k.Layout = layout.NewVBoxLayout()
+	k.Objects = []fyne.CanvasObject{
+		container.NewCenter(k.qr),
+		container.NewBorder(nil, nil, k.previous, k.next, k.label),
+	}
+	k.SetIndex(0)
+	return k, nil
+}
+
+// SetIndex shows the QR code at the index, starting at 0.
+func (k *KeyCodes) SetIndex(index int) {
+	if index < 0 || index >= len(k.Images) {
+		return
+	}
+	k.index = index
+	k.qr.Image = k.Images[index]
+	k.qr.Refresh()
+	k.label.SetText(fmt.Sprintf("%d of %d", index+1, len(k.Images)))
+	if index > 0 {
+		k.previous.Enable()
+	} else {
+		k.previous.Disable()
+	}
+	if index < len(k.Images)-1 {
+		k.next.Enable()
+	} else {
+		k.next.Disable()
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcfynego/ui"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+)
+
+func Test_KeyCodes(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	password := []byte("password1234")
+	data, err := storage.EncodeKeyFile(key, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := storage.EncodeKeyTransfer("Alice", data)
+	codes, err := ui.NewKeyCodes(parts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transfer := &storage.KeyTransfer{}
+	// Add in reverse to check order does not matter
+	for i := len(codes.Images) - 1; i >= 0; i-- {
+		code, err := ui.DecodeQRCode(codes.Images[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := transfer.Add(code); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if transfer.Alias != "Alice" {
+		t.Fatalf("Incorrect alias; expected 'Alice', got '%s'", transfer.Alias)
+	}
+	transferred, err := transfer.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := storage.ParseRSAKeyFile(transferred, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.D.Cmp(got.D) != 0 {
+		t.Fatal("Incorrect key")
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"bytes"
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MAX_NOTIFICATIONS is the number of recent notifications kept for click through.
+const MAX_NOTIFICATIONS = 50
+
+var errReachedPrevious = errors.New("Reached Previous Head")
+
+// Notification announces new records on a channel.
+type Notification struct {
+	Channel string
+	Time    time.Time
+	Records []storage.RecordURI
+}
+
+// Notifier announces new records on watched channels which match the rule of each channel.
+type Notifier struct {
+	sync.Mutex
+	ui            UI
+	client        bcclientgo.BCClient
+	app           fyne.App
+	notifications []*Notification
+	// OnUpdate is called after a notification is sent.
+	OnUpdate func()
+}
+
+func NewNotifier(ui UI, client bcclientgo.BCClient, app fyne.App) *Notifier {
+	return &Notifier{
+		ui:     ui,
+		client: client,
+		app:    app,
+	}
+}
+
+// Notifications returns the recent notifications, newest first.
+func (n *Notifier) Notifications() []*Notification {
+	n.Lock()
+	defer n.Unlock()
+	return append([]*Notification{}, n.notifications...)
+}
+
+// HeadChanged finds the records added between the two heads, and sends a notification for those matching the rule of the channel.
+// An empty previous head, as on the first sync of a newly watched channel, only sets the baseline and sends no notification.
+func (n *Notifier) HeadChanged(channel string, before, after []byte) {
+	if len(before) == 0 {
+		return
+	}
+	rule := storage.NotificationRuleFor(n.app.Preferences(), channel)
+	if !rule.Enabled {
+		return
+	}
+	cache, err := n.client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	var alias string
+	if n.client.HasAccount() {
+		if account, err := n.client.Account(); err == nil {
+			alias = account.Alias()
+		}
+	}
+	var records []storage.RecordURI
+	if err := bcgo.Iterate(channel, after, nil, cache, nil, func(hash []byte, block *bcgo.Block) error {
+		if bytes.Equal(hash, before) {
+			return errReachedPrevious
+		}
+		for _, entry := range block.Entry {
+			if rule.Matches(entry.Record, alias) {
+				records = append(records, storage.NewRecordURI(channel, hash, entry.RecordHash))
+			}
+		}
+		return nil
+	}); err != nil && err != errReachedPrevious {
+		log.Println(err)
+	}
+	if len(records) == 0 {
+		return
+	}
+	n.Lock()
+	n.notifications = append([]*Notification{&Notification{
+		Channel: channel,
+		Time:    time.Now(),
+		Records: records,
+	}}, n.notifications...)
+	if len(n.notifications) > MAX_NOTIFICATIONS {
+		n.notifications = n.notifications[:MAX_NOTIFICATIONS]
+	}
+	n.Unlock()
+	n.app.SendNotification(fyne.NewNotification(
+		"New Records in "+channel,
+		fmt.Sprintf("%d new records", len(records)),
+	))
+	if u := n.OnUpdate; u != nil {
+		u()
+	}
+}
+
+// NotificationView lists recent notifications with links to open each new record.
+type NotificationView struct {
+	fyne.Container
+	ui       UI
+	client   bcclientgo.BCClient
+	notifier *Notifier
+	list     *PageView
+}
+
+func NewNotificationView(ui UI, client bcclientgo.BCClient, notifier *Notifier) *NotificationView {
+	v := &NotificationView{
+		ui:       ui,
+		client:   client,
+		notifier: notifier,
+		list:     NewPageView(),
+	}
+	v.Layout = layout.NewVBoxLayout()
+	v.Objects = []fyne.CanvasObject{
+		v.list,
+	}
+	v.Update()
+	return v
+}
+
+// Update refreshes the view from the recent notifications of the notifier.
+func (v *NotificationView) Update() {
+	notifications := v.notifier.Notifications()
+	v.list.SetItems(len(notifications), func(i int) fyne.CanvasObject {
+		n := notifications[i]
+		items := container.NewVBox(widget.NewLabelWithStyle(fmt.Sprintf("%s %s", n.Time.Format("15:04"), n.Channel), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		for _, r := range n.Records {
+			uri := r
+			link := &Link{
+				Hyperlink: widget.Hyperlink{
+					Text: uri.String(),
+					TextStyle: fyne.TextStyle{
+						Monospace: true,
+					},
+					Wrapping: fyne.TextWrapBreak,
+				},
+				OnTapped: func() {
+					v.ui.ShowURI(v.client, uri)
+				},
+			}
+			link.ExtendBaseWidget(link)
+			items.Add(link)
+		}
+		return items
+	})
+}
+
+// ShowNotificationRule shows a dialog to configure which new records on the channel are announced.
+func ShowNotificationRule(channel string, window fyne.Window) {
+	preferences := fyne.CurrentApp().Preferences()
+	rule := storage.NotificationRuleFor(preferences, channel)
+	enabled := widget.NewCheck("Notify of New Records", nil)
+	enabled.SetChecked(rule.Enabled)
+	shared := widget.NewCheck("Only Records Shared With Me", nil)
+	shared.SetChecked(rule.SharedWithMe)
+	creators := widget.NewEntry()
+	creators.SetPlaceHolder("Any Creator")
+	creators.SetText(strings.Join(rule.Creators, ","))
+	dialog.ShowForm("Notifications for "+channel, "Save", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("", enabled),
+		widget.NewFormItem("", shared),
+		widget.NewFormItem("Creators", creators),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		rule := &storage.NotificationRule{
+			Enabled:      enabled.Checked,
+			SharedWithMe: shared.Checked,
+		}
+		for _, c := range bcgo.SplitRemoveEmpty(creators.Text, ",") {
+			rule.Creators = append(rule.Creators, strings.TrimSpace(c))
+		}
+		if err := storage.SetNotificationRule(preferences, channel, rule); err != nil {
+			dialog.ShowError(err, window)
+		}
+	}, window)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const PAGE_SIZE = 10
+
+// PageView shows a long list of items one page at a time.
+type PageView struct {
+	fyne.Container
+	items    *fyne.Container
+	label    *widget.Label
+	previous *widget.Button
+	next     *widget.Button
+	page     int
+	length   int
+	builder  func(int) fyne.CanvasObject
+}
+
+func NewPageView() *PageView {
+	v := &PageView{
+		items: container.NewVBox(),
+		label: widget.NewLabel(""),
+	}
+	v.previous = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		v.SetPage(v.page - 1)
+	})
+	v.next = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		v.SetPage(v.page + 1)
+	})
+	v.Layout = layout.NewVBoxLayout()
+	v.Objects = []fyne.CanvasObject{
+		v.items,
+		container.NewHBox(v.previous, v.label, v.next),
+	}
+	v.SetItems(0, nil)
+	return v
+}
+
+// SetItems sets the number of items and the function used to create the item at an index.
+func (v *PageView) SetItems(length int, builder func(int) fyne.CanvasObject) {
+	v.SetItemsPage(length, builder, 0)
+}
+
+// SetItemsPage sets the items as SetItems does, but shows the given page instead of the first, so each item is only built once.
+func (v *PageView) SetItemsPage(length int, builder func(int) fyne.CanvasObject, page int) {
+	v.length = length
+	v.builder = builder
+	v.SetPage(page)
+}
+
+func (v *PageView) SetPage(page int) {
+	pages := (v.length + PAGE_SIZE - 1) / PAGE_SIZE
+	if page >= pages {
+		page = pages - 1
+	}
+	if page < 0 {
+		page = 0
+	}
+	v.page = page
+	start := page * PAGE_SIZE
+	end := start + PAGE_SIZE
+	if end > v.length {
+		end = v.length
+	}
+	var items []fyne.CanvasObject
+	for i := start; i < end; i++ {
+		items = append(items, v.builder(i))
+	}
+	v.items.Objects = items
+	v.items.Refresh()
+	if v.length == 0 {
+		v.label.SetText("None")
+	} else {
+		v.label.SetText(fmt.Sprintf("%d-%d of %d", start+1, end, v.length))
+	}
+	if page > 0 {
+		v.previous.Enable()
+	} else {
+		v.previous.Disable()
+	}
+	if page < pages-1 {
+		v.next.Enable()
+	} else {
+		v.next.Disable()
+	}
+	v.Refresh()
+}
@@ -16,7 +16,21 @@
 
 package ui
 
-import "aletheiaware.com/bcgo"
+import (
+	"aletheiaware.com/bcgo"
+	"context"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"math"
+	"sync"
+	"time"
+)
+
+// HASH_BITS is the number of bits in a block hash, each of which is equally likely to be one.
+const HASH_BITS = 512
 
 type ProgressMiningListener struct {
 	Func func(f float64)
@@ -33,3 +47,126 @@ func (p *ProgressMiningListener) OnNewMaxOnes(channel bcgo.Channel, nonce, ones
 func (p *ProgressMiningListener) OnMiningThresholdReached(channel bcgo.Channel, hash []byte, block *bcgo.Block) {
 	p.Func(1.0)
 }
+
+// ExpectedAttempts returns the expected number of nonces tried before a block hash has at least threshold ones.
+// The number of ones is binomially distributed, approximated here by a normal distribution.
+func ExpectedAttempts(threshold uint64) float64 {
+	mean := HASH_BITS / 2.0
+	deviation := math.Sqrt(HASH_BITS / 4.0)
+	p := 0.5 * math.Erfc((float64(threshold)-0.5-mean)/(deviation*math.Sqrt2))
+	if p <= 0 {
+		return math.Inf(1)
+	}
+	return 1 / p
+}
+
+// EstimatingMiningListener reports progress like ProgressMiningListener, and estimates the time remaining from the rate nonces are tried.
+// As each nonce is equally likely to succeed the estimate does not shrink as mining goes on, it only becomes more accurate.
+type EstimatingMiningListener struct {
+	Threshold uint64
+	Func      func(f float64)
+	Estimate  func(remaining time.Duration)
+	start     time.Time
+}
+
+func (e *EstimatingMiningListener) OnMiningStarted(channel bcgo.Channel, size uint64) {
+	e.start = time.Now()
+	e.Func(0.0)
+}
+
+func (e *EstimatingMiningListener) OnNewMaxOnes(channel bcgo.Channel, nonce, ones uint64) {
+	e.Func(float64(ones) / HASH_BITS)
+	elapsed := time.Since(e.start).Seconds()
+	if nonce == 0 || elapsed <= 0 || e.Estimate == nil {
+		return
+	}
+	rate := float64(nonce) / elapsed
+	e.Estimate(time.Duration(ExpectedAttempts(e.Threshold) / rate * float64(time.Second)))
+}
+
+func (e *EstimatingMiningListener) OnMiningThresholdReached(channel bcgo.Channel, hash []byte, block *bcgo.Block) {
+	e.Func(1.0)
+	if e.Estimate != nil {
+		e.Estimate(0)
+	}
+}
+
+// CancellableProgress is a progress dialog whose Cancel button cancels the context it was created with.
+// Hiding the dialog once the work is done does not cancel the context, so its error still tells whether the user cancelled.
+type CancellableProgress struct {
+	dialog.Dialog
+	lock     sync.Mutex
+	hidden   bool
+	message  *widget.Label
+	bar      *widget.ProgressBar
+	infinite *widget.ProgressBarInfinite
+	estimate *widget.Label
+}
+
+// NewCancellableProgress returns a progress dialog and a context which is cancelled when the Cancel button is tapped.
+// The progress starts as infinite until a value is set.
+func NewCancellableProgress(parent context.Context, title, message string, window fyne.Window) (*CancellableProgress, context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	p := &CancellableProgress{
+		message:  widget.NewLabel(message),
+		bar:      widget.NewProgressBar(),
+		infinite: widget.NewProgressBarInfinite(),
+		estimate: widget.NewLabel(""),
+	}
+	p.bar.Hide()
+	p.Dialog = dialog.NewCustom(title, "Cancel", container.NewVBox(p.message, p.bar, p.infinite, p.estimate), window)
+	p.Dialog.SetOnClosed(func() {
+		p.lock.Lock()
+		hidden := p.hidden
+		p.lock.Unlock()
+		if !hidden {
+			cancel()
+		}
+	})
+	return p, ctx
+}
+
+// Hide hides the dialog without cancelling the context.
+func (p *CancellableProgress) Hide() {
+	p.lock.Lock()
+	p.hidden = true
+	p.lock.Unlock()
+	p.Dialog.Hide()
+}
+
+// SetMessage replaces the message describing the current phase, and returns to infinite progress.
+func (p *CancellableProgress) SetMessage(message string) {
+	p.message.SetText(message)
+	p.estimate.SetText("")
+	p.bar.Hide()
+	p.infinite.Show()
+	p.infinite.Start()
+}
+
+// SetValue shows the given progress between 0 and 1.
+func (p *CancellableProgress) SetValue(value float64) {
+	if p.infinite.Visible() {
+		p.infinite.Stop()
+		p.infinite.Hide()
+		p.bar.Show()
+	}
+	p.bar.SetValue(value)
+}
+
+// SetEstimate shows the estimated time remaining.
+func (p *CancellableProgress) SetEstimate(remaining time.Duration) {
+	if remaining <= 0 {
+		p.estimate.SetText("")
+		return
+	}
+	p.estimate.SetText(fmt.Sprintf("About %s remaining", remaining.Round(time.Second)))
+}
+
+// MiningListener returns a listener which shows mining progress and the estimated time remaining to reach the threshold.
+func (p *CancellableProgress) MiningListener(threshold uint64) bcgo.MiningListener {
+	return &EstimatingMiningListener{
+		Threshold: threshold,
+		Func:      p.SetValue,
+		Estimate:  p.SetEstimate,
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/ui"
+	"context"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"testing"
+)
+
+func Test_CancellableProgress(t *testing.T) {
+	a := test.NewApp()
+	defer a.Quit()
+	t.Run("Hide", func(t *testing.T) {
+		w := a.NewWindow("Test")
+		defer w.Close()
+		progress, ctx := ui.NewCancellableProgress(context.Background(), "Title", "Message", w)
+		progress.Show()
+		progress.Hide()
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("Incorrect error; expected nil, got '%v'", err)
+		}
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		w := a.NewWindow("Test")
+		defer w.Close()
+		progress, ctx := ui.NewCancellableProgress(context.Background(), "Title", "Message", w)
+		progress.Show()
+		var cancel *widget.Button
+		for _, o := range test.LaidOutObjects(w.Canvas().Overlays().Top()) {
+			if b, ok := o.(*widget.Button); ok && b.Text == "Cancel" {
+				cancel = b
+			}
+		}
+		if cancel == nil {
+			t.Fatal("Cancel button not found")
+		}
+		test.Tap(cancel)
+		if err := ctx.Err(); err != context.Canceled {
+			t.Fatalf("Incorrect error; expected '%v', got '%v'", context.Canceled, err)
+		}
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
+	"log"
 	"sort"
 )
 
@@ -32,6 +33,7 @@ type RecordView struct {
 	widget.Form
 	ui                   UI
 	client               bcclientgo.BCClient
+	warning              *fyne.Container
 	hash                 *widget.Label
 	timestamp            *TimestampLabel
 	creator              *Link
@@ -43,12 +45,15 @@ type RecordView struct {
 	signatureAlgorithm   *widget.Label
 	reference            *fyne.Container
 	meta                 *fyne.Container
+	referencedBy         *fyne.Container
+	actions              *fyne.Container
 }
 
 func NewRecordView(ui UI, client bcclientgo.BCClient) *RecordView {
 	v := &RecordView{
-		ui:     ui,
-		client: client,
+		ui:      ui,
+		client:  client,
+		warning: container.NewVBox(),
 		hash: &widget.Label{
 			TextStyle: fyne.TextStyle{
 				Monospace: true,
@@ -95,8 +100,10 @@ func NewRecordView(ui UI, client bcclientgo.BCClient) *RecordView {
 			},
 			Wrapping: fyne.TextWrapBreak,
 		},
-		reference: container.NewVBox(),
-		meta:      container.NewVBox(),
+		reference:    container.NewVBox(),
+		meta:         container.NewVBox(),
+		referencedBy: container.NewVBox(),
+		actions:      container.NewGridWithColumns(2),
 	}
 	v.ExtendBaseWidget(v)
 	v.hash.ExtendBaseWidget(v.hash)
@@ -107,6 +114,7 @@ func NewRecordView(ui UI, client bcclientgo.BCClient) *RecordView {
 	v.encryptionAlgorithm.ExtendBaseWidget(v.encryptionAlgorithm)
 	v.signature.ExtendBaseWidget(v.signature)
 	v.signatureAlgorithm.ExtendBaseWidget(v.signatureAlgorithm)
+	v.Append("", v.warning)
 	v.Append("Hash", v.hash)
 	v.Append("Timestamp", v.timestamp)
 	v.Append("Creator", v.creator)
@@ -118,6 +126,8 @@ func NewRecordView(ui UI, client bcclientgo.BCClient) *RecordView {
 	v.Append("Signature", v.signatureAlgorithm)
 	v.Append("References", v.reference)
 	v.Append("Metadata", v.meta)
+	v.Append("Referenced By", v.referencedBy)
+	v.Append("", v.actions)
 	return v
 }
 
@@ -138,18 +148,46 @@ func (v *RecordView) SetURI(uri storage.RecordURI) error {
 	if err != nil {
 		return err
 	}
-	v.SetHash(recordHash)
-	for _, entry := range block.Entry {
-		if bytes.Equal(recordHash, entry.RecordHash) {
-			v.SetRecord(entry.Record)
+	var entry *bcgo.BlockEntry
+	for _, e := range block.Entry {
+		if bytes.Equal(recordHash, e.RecordHash) {
+			entry = e
 			break
 		}
 	}
+	actions := []fyne.CanvasObject{
+		widget.NewButton("Graph", func() {
+			go ShowGraph(v.ui, v.client, uri)
+		}),
+	}
+	if entry != nil && CanDecrypt(node.Account(), entry.Record) != nil {
+		actions = append(actions, widget.NewButton("Share", func() {
+			ShowShare(v.ui, v.client, uri, entry, WindowForObject(v))
+		}))
+	}
+	v.actions.Objects = actions
+	v.actions.Refresh()
+	v.SetHash(recordHash)
+	if entry != nil {
+		v.SetRecord(entry.Record)
+	}
 	return nil
 }
 
 func (v *RecordView) SetHash(hash []byte) {
 	v.hash.SetText(base64.RawURLEncoding.EncodeToString(hash))
+	var backlinks []fyne.CanvasObject
+	if index, err := v.ui.ReferenceIndex(v.client); err != nil {
+		log.Println(err)
+	} else {
+		for _, b := range index.ReferencedBy(hash) {
+			v := NewBacklinkView(v.ui, v.client)
+			v.SetBacklink(b)
+			backlinks = append(backlinks, v)
+		}
+	}
+	v.referencedBy.Objects = backlinks
+	v.referencedBy.Refresh()
 }
 
 func (v *RecordView) SetRecord(record *bcgo.Record) {
@@ -158,10 +196,17 @@ func (v *RecordView) SetRecord(record *bcgo.Record) {
 	v.creator.OnTapped = func() {
 		v.ui.ShowURI(v.client, storage.NewAliasURI(record.Creator))
 	}
+	checker := newPinnedKeyChecker(v.ui, v.client)
+	var warnings []fyne.CanvasObject
+	if w := checker.Check(record.Creator); w != nil {
+		warnings = append(warnings, w)
+	}
+	v.warning.Objects = warnings
+	v.warning.Refresh()
 	var accesses []fyne.CanvasObject
 	for _, a := range record.Access {
 		v := NewAccessView(v.ui, v.client)
-		v.SetAccess(a)
+		v.setAccess(a, checker)
 		accesses = append(accesses, v)
 	}
 	v.access.Objects = accesses
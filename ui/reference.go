@@ -23,6 +23,7 @@ import (
 	"encoding/base64"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/widget"
+	"log"
 	"strconv"
 )
 
@@ -104,3 +105,106 @@ func (v *ReferenceView) SetReference(reference *bcgo.Reference) {
 	v.index.SetText(strconv.FormatUint(reference.Index, 10))
 	v.Refresh()
 }
+
+// UpdateReferenceIndex indexes the cached blocks of every cached channel, logging any errors.
+// Only the cache is read, channels already indexed up to their cached head are skipped.
+func UpdateReferenceIndex(ui UI, client bcclientgo.BCClient) {
+	index, err := ui.ReferenceIndex(client)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	cache, err := client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	heads, err := CachedHeads(client, cache)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for name, head := range heads {
+		if err := index.Update(name, head, cache, nil); err != nil {
+			// Only part of the channel is cached
+			log.Println(err)
+		}
+	}
+}
+
+// UpdateChannelReferences indexes the cached blocks of the given channel, logging any errors.
+func UpdateChannelReferences(ui UI, client bcclientgo.BCClient, name string) {
+	index, err := ui.ReferenceIndex(client)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	cache, err := client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	head, err := cache.Head(name)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if err := index.Update(name, head.BlockHash, cache, nil); err != nil {
+		// Only part of the channel is cached
+		log.Println(err)
+	}
+}
+
+type BacklinkView struct {
+	widget.Form
+	ui        UI
+	client    bcclientgo.BCClient
+	timestamp *TimestampLabel
+	creator   *Link
+	record    *Link
+}
+
+func NewBacklinkView(ui UI, client bcclientgo.BCClient) *BacklinkView {
+	v := &BacklinkView{
+		ui:        ui,
+		client:    client,
+		timestamp: NewTimestampLabel(0),
+		creator: &Link{
+			Hyperlink: widget.Hyperlink{
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+				Wrapping: fyne.TextWrapBreak,
+			},
+		},
+		record: &Link{
+			Hyperlink: widget.Hyperlink{
+				TextStyle: fyne.TextStyle{
+					Monospace: true,
+				},
+				Wrapping: fyne.TextWrapBreak,
+			},
+		},
+	}
+	v.ExtendBaseWidget(v)
+	v.timestamp.ExtendBaseWidget(v.timestamp)
+	v.creator.ExtendBaseWidget(v.creator)
+	v.record.ExtendBaseWidget(v.record)
+	v.Append("Timestamp", v.timestamp)
+	v.Append("Creator", v.creator)
+	v.Append("Record", v.record)
+	return v
+}
+
+func (v *BacklinkView) SetBacklink(backlink *storage.Backlink) {
+	v.timestamp.SetTimestamp(backlink.Timestamp)
+	v.creator.SetText(backlink.Creator)
+	v.creator.OnTapped = func() {
+		v.ui.ShowURI(v.client, storage.NewAliasURI(backlink.Creator))
+	}
+	v.record.SetText(backlink.Channel + "/" + base64.RawURLEncoding.EncodeToString(backlink.RecordHash))
+	v.record.OnTapped = func() {
+		v.ui.ShowURI(v.client, backlink.URI())
+	}
+	v.Refresh()
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"bytes"
+	"fyne.io/fyne/v2"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// SYNC_JITTER is the fraction of the interval by which each sync is randomly delayed.
+	SYNC_JITTER = 0.1
+	// MAX_BACKOFF is the longest a peer failing to sync a channel is skipped for.
+	MAX_BACKOFF = 24 * time.Hour
+)
+
+// ChannelStatus holds the latest result of syncing a watched channel with each peer.
+type ChannelStatus struct {
+	Channel string
+	Synced  time.Time
+	Results map[string]*PeerResult
+}
+
+// backoffKey identifies a channel synced with a peer, as a peer may fail for one channel but not another.
+type backoffKey struct {
+	peer    string
+	channel string
+}
+
+type backoff struct {
+	failures int
+	until    time.Time
+}
+
+// Scheduler periodically pulls, and optionally pushes, the watched channels with every peer.
+type Scheduler struct {
+	sync.Mutex
+	client      bcclientgo.BCClient
+	preferences fyne.Preferences
+	statuses    map[string]*ChannelStatus
+	backoffs    map[backoffKey]*backoff
+	next        time.Time
+	wake        chan struct{}
+	stop        chan struct{}
+	// OnUpdate is called after each channel is synced.
+	OnUpdate func()
+	// OnHeadChanged is called when the head of a channel advances during a sync.
+	OnHeadChanged func(channel string, before, after []byte)
+}
+
+func NewScheduler(client bcclientgo.BCClient, preferences fyne.Preferences) *Scheduler {
+	return &Scheduler{
+		client:      client,
+		preferences: preferences,
+		statuses:    make(map[string]*ChannelStatus),
+		backoffs:    make(map[backoffKey]*backoff),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Start runs the scheduler in the background until Stop is called.
+func (s *Scheduler) Start() {
+	s.Lock()
+	defer s.Unlock()
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+	go s.run(s.stop)
+}
+
+// Stop halts the scheduler.
+func (s *Scheduler) Stop() {
+	s.Lock()
+	defer s.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// SyncNow wakes the scheduler to sync all watched channels immediately.
+func (s *Scheduler) SyncNow() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+		// Already awake
+	}
+}
+
+// Next returns the time of the next scheduled sync.
+func (s *Scheduler) Next() time.Time {
+	s.Lock()
+	defer s.Unlock()
+	return s.next
+}
+
+// Statuses returns the status of each watched channel, sorted by name.
+func (s *Scheduler) Statuses() []*ChannelStatus {
+	s.Lock()
+	defer s.Unlock()
+	var statuses []*ChannelStatus
+	for _, c := range storage.WatchedChannels(s.preferences) {
+		if status, ok := s.statuses[c]; ok {
+			statuses = append(statuses, status)
+		} else {
+			statuses = append(statuses, &ChannelStatus{
+				Channel: c,
+			})
+		}
+	}
+	return statuses
+}
+
+// BackedOff returns the time until which the given channel is not synced with the given peer, or the zero time if it is.
+func (s *Scheduler) BackedOff(peer, channel string) time.Time {
+	s.Lock()
+	defer s.Unlock()
+	if b, ok := s.backoffs[backoffKey{peer, channel}]; ok && time.Now().Before(b.until) {
+		return b.until
+	}
+	return time.Time{}
+}
+
+func (s *Scheduler) run(stop chan struct{}) {
+	for {
+		s.syncAll()
+		interval := storage.SyncInterval(s.preferences)
+		delay := interval + time.Duration(rand.Float64()*SYNC_JITTER*float64(interval))
+		s.Lock()
+		s.next = time.Now().Add(delay)
+		s.Unlock()
+		s.update()
+		timer := time.NewTimer(delay)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *Scheduler) syncAll() {
+	cache, err := s.client.Cache()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	push := storage.SyncPush(s.preferences)
+	peers := s.client.Peers()
+	sort.Strings(peers)
+	for _, channel := range storage.WatchedChannels(s.preferences) {
+		status := &ChannelStatus{
+			Channel: channel,
+			Results: make(map[string]*PeerResult),
+		}
+		var before, after []byte
+		for _, peer := range peers {
+			if !s.BackedOff(peer, channel).IsZero() {
+				continue
+			}
+			n, err := PeerNetwork(s.client, peer)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			result := SyncPeer(cache, n, channel, peer, false, nil)
+			if push {
+				// Push whatever the pull returned, as a local chain ahead of the peer is exactly when a push is needed
+				pushed := SyncPeer(cache, n, channel, peer, true, nil)
+				if err := pushed.Error; err != nil && !IsChainTooShort(err) && result.Error == nil {
+					result.Error = err
+				}
+			}
+			if before == nil {
+				before = result.OldHead
+			}
+			after = result.NewHead
+			s.record(peer, channel, result.Error)
+			status.Results[peer] = result
+		}
+		status.Synced = time.Now()
+		s.Lock()
+		s.statuses[channel] = status
+		s.Unlock()
+		if len(after) > 0 && !bytes.Equal(before, after) {
+			if c := s.OnHeadChanged; c != nil {
+				c(channel, before, after)
+			}
+		}
+		s.update()
+	}
+}
+
+// record resets the backoff of the channel with the peer on success, and doubles it on failure.
+// A chain too short to replace the other is not a failure, as it only means one side is behind.
+func (s *Scheduler) record(peer, channel string, err error) {
+	s.Lock()
+	defer s.Unlock()
+	key := backoffKey{peer, channel}
+	if err == nil || IsChainTooShort(err) {
+		delete(s.backoffs, key)
+		return
+	}
+	b, ok := s.backoffs[key]
+	if !ok {
+		b = &backoff{}
+		s.backoffs[key] = b
+	}
+	b.failures++
+	delay := storage.SyncInterval(s.preferences)
+	for i := 1; i < b.failures && delay < MAX_BACKOFF; i++ {
+		delay *= 2
+	}
+	if delay > MAX_BACKOFF {
+		delay = MAX_BACKOFF
+	}
+	b.until = time.Now().Add(delay)
+}
+
+func (s *Scheduler) update() {
+	if u := s.OnUpdate; u != nil {
+		u()
+	}
+}
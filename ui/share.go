@@ -0,0 +1,163 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"strings"
+)
+
+var ErrNoAccess = errors.New("Account cannot decrypt record")
+
+// CanDecrypt returns the access entry allowing the given account to decrypt the record, or nil.
+func CanDecrypt(account bcgo.Account, record *bcgo.Record) *bcgo.Record_Access {
+	if account == nil {
+		return nil
+	}
+	alias := account.Alias()
+	for _, a := range record.Access {
+		if a.Alias == alias {
+			return a
+		}
+	}
+	return nil
+}
+
+// Decrypt decrypts the entry with the account, falling back to the previous keys of the alias archived by rotation.
+func Decrypt(account bcgo.Account, previous []bcgo.Account, entry *bcgo.BlockEntry, access *bcgo.Record_Access, callback func(*bcgo.BlockEntry, []byte, []byte) error) error {
+	err := account.Decrypt(entry, access, callback)
+	if err == nil {
+		return nil
+	}
+	for _, p := range previous {
+		if e := p.Decrypt(entry, access, callback); e == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// ShareRecord decrypts the given entry with the account, or one of its previous keys, re-encrypts the payload for the account and the given identities,
+// and writes the result to the channel as a new record referencing the original.
+// The aliases which did not have access to the original record are returned.
+func ShareRecord(node bcgo.Node, previous []bcgo.Account, uri storage.RecordURI, entry *bcgo.BlockEntry, channel string, identities []bcgo.Identity) ([]string, error) {
+	account := node.Account()
+	access := CanDecrypt(account, entry.Record)
+	if access == nil {
+		return nil, ErrNoAccess
+	}
+	var payload []byte
+	if err := Decrypt(account, previous, entry, access, func(e *bcgo.BlockEntry, key, data []byte) error {
+		payload = data
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, a := range entry.Record.Access {
+		existing[a.Alias] = true
+	}
+	var gained []string
+	recipients := []bcgo.Identity{account}
+	for _, i := range identities {
+		alias := i.Alias()
+		if alias == account.Alias() {
+			continue
+		}
+		recipients = append(recipients, i)
+		if !existing[alias] {
+			gained = append(gained, alias)
+		}
+	}
+	references := []*bcgo.Reference{
+		&bcgo.Reference{
+			Timestamp:   entry.Record.Timestamp,
+			ChannelName: uri.Channel(),
+			BlockHash:   uri.BlockHash(),
+			RecordHash:  uri.RecordHash(),
+		},
+	}
+	_, record, err := bcgo.CreateRecord(bcgo.Timestamp(), account, recipients, references, payload)
+	if err != nil {
+		return nil, err
+	}
+	// Payload is shared as stored, so keep the original compression and metadata
+	record.CompressionAlgorithm = entry.Record.CompressionAlgorithm
+	if len(entry.Record.Meta) > 0 {
+		record.Meta = make(map[string]string, len(entry.Record.Meta))
+		for k, v := range entry.Record.Meta {
+			record.Meta[k] = v
+		}
+	}
+	if _, err := bcgo.WriteRecord(channel, node.Cache(), record); err != nil {
+		return nil, err
+	}
+	return gained, nil
+}
+
+// ShowShare shows a dialog to share the given record with additional aliases in a chosen channel.
+func ShowShare(ui UI, client bcclientgo.BCClient, uri storage.RecordURI, entry *bcgo.BlockEntry, window fyne.Window) {
+	channel := widget.NewEntry()
+	channel.SetText(uri.Channel())
+	channel.Wrapping = fyne.TextWrapOff
+	composer := NewComposer(ui, client, window)
+	go composer.LoadAliases()
+	form := widget.NewForm(
+		widget.NewFormItem("Channel", channel),
+		widget.NewFormItem("Access", composer.AccessObject()),
+	)
+	d := dialog.NewCustomConfirm("Share Record", "Share", "Cancel", form, func(ok bool) {
+		if !ok {
+			return
+		}
+		go func() {
+			name := strings.TrimSpace(channel.Text)
+			if err := bcgo.ValidateName(name); err != nil {
+				ui.ShowError(err)
+				return
+			}
+			if len(composer.access) == 0 {
+				ui.ShowError(errors.New("No aliases to share with"))
+				return
+			}
+			node, err := ui.Node(client)
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			gained, err := ShareRecord(node, ui.PreviousAccounts(client), uri, entry, name, composer.access)
+			if err != nil {
+				ui.ShowError(err)
+				return
+			}
+			message := "All aliases already had access"
+			if len(gained) > 0 {
+				message = fmt.Sprintf("Access granted to %s", strings.Join(gained, ", "))
+			}
+			dialog.ShowInformation("Record Shared", fmt.Sprintf("%s\nRecord written to %s, mine the channel to add it to a block", message, name), window)
+		}()
+	}, window)
+	d.Show()
+	d.Resize(DialogSize)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"sort"
+	"strings"
+)
+
+// SyncStatusView summarizes the latest sync of each watched channel.
+type SyncStatusView struct {
+	fyne.Container
+	ui        UI
+	client    bcclientgo.BCClient
+	scheduler *Scheduler
+	next      *widget.Label
+	channels  *fyne.Container
+}
+
+func NewSyncStatusView(ui UI, client bcclientgo.BCClient, scheduler *Scheduler) *SyncStatusView {
+	v := &SyncStatusView{
+		ui:        ui,
+		client:    client,
+		scheduler: scheduler,
+		next:      widget.NewLabel(""),
+		channels:  container.NewVBox(),
+	}
+	v.Layout = layout.NewVBoxLayout()
+	v.Objects = []fyne.CanvasObject{
+		container.NewBorder(nil, nil, nil, widget.NewButtonWithIcon("Sync Now", theme.ViewRefreshIcon(), func() {
+			v.next.SetText("Syncing")
+			scheduler.SyncNow()
+		}), v.next),
+		v.channels,
+	}
+	v.Update()
+	return v
+}
+
+// Update refreshes the view from the latest statuses of the scheduler.
+func (v *SyncStatusView) Update() {
+	if next := v.scheduler.Next(); next.IsZero() {
+		v.next.SetText("Syncing")
+	} else {
+		v.next.SetText("Next sync at " + next.Format("15:04"))
+	}
+	var rows []fyne.CanvasObject
+	for _, s := range v.scheduler.Statuses() {
+		rows = append(rows, v.row(s))
+	}
+	if len(rows) == 0 {
+		rows = append(rows, widget.NewLabel("No watched channels"))
+	}
+	v.channels.Objects = rows
+	v.channels.Refresh()
+}
+
+func (v *SyncStatusView) row(status *ChannelStatus) fyne.CanvasObject {
+	name := status.Channel
+	link := &Link{
+		Hyperlink: widget.Hyperlink{
+			Text: name,
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+		},
+		OnTapped: func() {
+			v.ui.ShowURI(v.client, storage.NewChannelURI(name))
+		},
+	}
+	link.ExtendBaseWidget(link)
+	summary := "Not yet synced"
+	if !status.Synced.IsZero() {
+		var peers []string
+		for p := range status.Results {
+			peers = append(peers, p)
+		}
+		sort.Strings(peers)
+		ok := 0
+		var failures []string
+		for _, p := range peers {
+			if err := status.Results[p].Error; err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", p, err))
+			} else {
+				ok++
+			}
+		}
+		summary = fmt.Sprintf("%s, %d/%d peers", status.Synced.Format("15:04"), ok, len(peers))
+		if len(failures) > 0 {
+			summary += "\n" + strings.Join(failures, "\n")
+		}
+	}
+	return container.NewBorder(nil, nil, link, nil, &widget.Label{
+		Text:     summary,
+		Wrapping: fyne.TextWrapWord,
+	})
+}
@@ -0,0 +1,325 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/channel"
+	"aletheiaware.com/bcgo/network"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/golang/protobuf/proto"
+	"log"
+	"sync"
+)
+
+// ErrNoPeers is returned when syncing a channel without any peers configured.
+var ErrNoPeers = errors.New("No peers configured")
+
+// PeerResult holds the outcome of pulling or pushing a channel with a single peer.
+type PeerResult struct {
+	Peer       string
+	Push       bool
+	OldHead    []byte
+	NewHead    []byte
+	RemoteHead []byte
+	Blocks     int
+	Bytes      uint64
+	// Behind is set when a pull was refused as the chain of the peer is no longer than the local chain.
+	Behind bool
+	Error  error
+}
+
+// IsChainTooShort returns true if the error is bcgo.ErrChainTooShort, which a pull returns when the local chain is at least as long as the remote.
+func IsChainTooShort(err error) bool {
+	var e bcgo.ErrChainTooShort
+	return errors.As(err, &e)
+}
+
+// countingNetwork counts the blocks and bytes transferred through a network.
+type countingNetwork struct {
+	bcgo.Network
+	sync.Mutex
+	blocks   int
+	bytes    uint64
+	progress func(int, uint64)
+}
+
+func (n *countingNetwork) Block(reference *bcgo.Reference) (*bcgo.Block, error) {
+	block, err := n.Network.Block(reference)
+	if err == nil {
+		n.count(block)
+	}
+	return block, err
+}
+
+func (n *countingNetwork) count(block *bcgo.Block) {
+	n.Lock()
+	n.blocks++
+	n.bytes += uint64(proto.Size(block))
+	blocks, bytes := n.blocks, n.bytes
+	n.Unlock()
+	if p := n.progress; p != nil {
+		p(blocks, bytes)
+	}
+}
+
+// PeerNetwork returns the network of the client limited to the given peer.
+// The timeouts of a TCP network are kept, other networks cannot be limited and are returned as they are.
+func PeerNetwork(client bcclientgo.BCClient, peer string) (bcgo.Network, error) {
+	n, err := client.Network()
+	if err != nil {
+		return nil, err
+	}
+	if tcp, ok := n.(*network.TCP); ok {
+		t := network.NewTCP(peer)
+		t.DialTimeout = tcp.DialTimeout
+		t.GetTimeout = tcp.GetTimeout
+		return t, nil
+	}
+	return n, nil
+}
+
+// SyncPeer pulls the channel from, or pushes it to, the given peer, reporting the blocks and bytes transferred so far.
+func SyncPeer(cache bcgo.Cache, peerNetwork bcgo.Network, name, peer string, push bool, progress func(int, uint64)) *PeerResult {
+	result := &PeerResult{
+		Peer: peer,
+		Push: push,
+	}
+	n := &countingNetwork{
+		Network:  peerNetwork,
+		progress: progress,
+	}
+	c := channel.New(name)
+	if err := c.Load(cache, nil); err != nil {
+		// Ignored
+	}
+	result.OldHead = c.Head()
+	if head, err := n.Head(name); err == nil {
+		result.RemoteHead = head.BlockHash
+	}
+	if push {
+		result.Error = c.Push(cache, n)
+		if result.Error == nil {
+			// Broadcast sends every block the peer is missing, so count the blocks after the remote head
+			result.Blocks, result.Bytes = countBlocks(cache, name, c.Head(), result.RemoteHead)
+			if p := progress; p != nil {
+				p(result.Blocks, result.Bytes)
+			}
+		}
+	} else {
+		result.Error = c.Pull(cache, n)
+		if IsChainTooShort(result.Error) {
+			result.Behind = true
+			result.Error = nil
+		}
+		result.Blocks = n.blocks
+		result.Bytes = n.bytes
+	}
+	result.NewHead = c.Head()
+	return result
+}
+
+// countBlocks returns the number and size of the cached blocks from the head back to, but excluding, the given ancestor.
+func countBlocks(cache bcgo.Cache, name string, head, ancestor []byte) (count int, size uint64) {
+	if err := bcgo.Iterate(name, head, nil, cache, nil, func(hash []byte, block *bcgo.Block) error {
+		if bytes.Equal(hash, ancestor) {
+			return bcgo.StopIterationError{}
+		}
+		count++
+		size += uint64(proto.Size(block))
+		return nil
+	}); err != nil {
+		if _, ok := err.(bcgo.StopIterationError); !ok {
+			log.Println(err)
+		}
+	}
+	return
+}
+
+func formatHead(head []byte) string {
+	if len(head) == 0 {
+		return "None"
+	}
+	return base64.RawURLEncoding.EncodeToString(head)
+}
+
+// ShowSync pulls or pushes the channel with each peer of the client in the background, showing progress per peer,
+// followed by a summary from which failed peers can be retried.
+func ShowSync(ui UI, client bcclientgo.BCClient, name string, push bool, window fyne.Window, callback func([]*PeerResult)) {
+	cache, err := client.Cache()
+	if err != nil {
+		ui.ShowError(err)
+		return
+	}
+	action := "Pulling"
+	if push {
+		action = "Pushing"
+	}
+	peers := client.Peers()
+	if len(peers) == 0 {
+		ui.ShowError(ErrNoPeers)
+		return
+	}
+	rows := container.NewVBox()
+	statuses := make([]*widget.Label, len(peers))
+	for i, p := range peers {
+		statuses[i] = widget.NewLabel("Waiting")
+		rows.Add(widget.NewForm(widget.NewFormItem(p, statuses[i])))
+	}
+	progress := dialog.NewCustom(action+" "+name, "Hide", container.NewVScroll(rows), window)
+	progress.Show()
+	progress.Resize(DialogSize)
+	go func() {
+		// Peers are synced in turn as they share the cache
+		results := make([]*PeerResult, len(peers))
+		for i, peer := range peers {
+			status := statuses[i]
+			status.SetText(action)
+			n, err := PeerNetwork(client, peer)
+			if err != nil {
+				results[i] = &PeerResult{
+					Peer:  peer,
+					Push:  push,
+					Error: err,
+				}
+			} else {
+				results[i] = SyncPeer(cache, n, name, peer, push, func(blocks int, bytes uint64) {
+					status.SetText(fmt.Sprintf("%d blocks, %s", blocks, bcgo.BinarySizeToString(bytes)))
+				})
+			}
+			if err := results[i].Error; err != nil {
+				status.SetText(err.Error())
+			} else {
+				status.SetText("Done")
+			}
+		}
+		progress.Hide()
+		// Callback may show further dialogs, so wait until the summary is dismissed
+		ShowSyncSummary(ui, client, name, results, window, func() {
+			if c := callback; c != nil {
+				c(results)
+			}
+		})
+	}()
+}
+
+// ShowSyncSummary shows the outcome for each peer, with an option to retry those which failed.
+// The callback is called once the summary is closed.
+func ShowSyncSummary(ui UI, client bcclientgo.BCClient, name string, results []*PeerResult, window fyne.Window, callback func()) {
+	list := container.NewVBox()
+	for _, r := range results {
+		list.Add(NewPeerResultView(ui, client, name, r))
+	}
+	title := "Pull"
+	if len(results) > 0 && results[0].Push {
+		title = "Push"
+	}
+	d := dialog.NewCustom(title+" "+name, "OK", container.NewVScroll(list), window)
+	if c := callback; c != nil {
+		d.SetOnClosed(c)
+	}
+	d.Show()
+	d.Resize(DialogSize)
+}
+
+// PeerResultView shows the outcome of syncing with a peer.
+type PeerResultView struct {
+	widget.Form
+	ui     UI
+	client bcclientgo.BCClient
+	name   string
+	head   *widget.Label
+	bytes  *widget.Label
+	status *widget.Label
+	retry  *widget.Button
+}
+
+func NewPeerResultView(ui UI, client bcclientgo.BCClient, name string, result *PeerResult) *PeerResultView {
+	v := &PeerResultView{
+		ui:     ui,
+		client: client,
+		name:   name,
+		head: &widget.Label{
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+			Wrapping: fyne.TextWrapBreak,
+		},
+		bytes: &widget.Label{
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+		},
+		status: &widget.Label{
+			Wrapping: fyne.TextWrapWord,
+		},
+	}
+	v.retry = widget.NewButton("Retry", func() {
+		v.retry.Disable()
+		v.status.SetText("Retrying")
+		go func() {
+			cache, err := v.client.Cache()
+			if err != nil {
+				v.ui.ShowError(err)
+				return
+			}
+			n, err := PeerNetwork(v.client, result.Peer)
+			if err != nil {
+				v.ui.ShowError(err)
+				return
+			}
+			v.SetResult(SyncPeer(cache, n, v.name, result.Peer, result.Push, func(blocks int, bytes uint64) {
+				v.status.SetText(fmt.Sprintf("%d blocks, %s", blocks, bcgo.BinarySizeToString(bytes)))
+			}))
+		}()
+	})
+	v.ExtendBaseWidget(v)
+	v.head.ExtendBaseWidget(v.head)
+	v.bytes.ExtendBaseWidget(v.bytes)
+	v.status.ExtendBaseWidget(v.status)
+	v.Append("Peer", widget.NewLabel(result.Peer))
+	v.Append("Head", v.head)
+	v.Append("Transferred", v.bytes)
+	v.Append("Status", container.NewBorder(nil, nil, nil, v.retry, v.status))
+	v.SetResult(result)
+	return v
+}
+
+func (v *PeerResultView) SetResult(result *PeerResult) {
+	v.head.SetText(fmt.Sprintf("%s\n→ %s", formatHead(result.OldHead), formatHead(result.NewHead)))
+	v.bytes.SetText(fmt.Sprintf("%d blocks, %s", result.Blocks, bcgo.BinarySizeToString(result.Bytes)))
+	if err := result.Error; err != nil {
+		v.status.SetText(err.Error())
+		v.retry.Show()
+		v.retry.Enable()
+	} else if result.Behind {
+		v.status.SetText("OK, the chain of the peer is no longer than ours")
+		v.retry.Hide()
+	} else {
+		v.status.SetText("OK")
+		v.retry.Hide()
+	}
+	v.Refresh()
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui_test
+
+import (
+	"aletheiaware.com/bcfynego/ui"
+	"aletheiaware.com/bcgo"
+	"aletheiaware.com/bcgo/cache"
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// peerNetwork serves the channels of a cache as a single remote peer.
+type peerNetwork struct {
+	cache bcgo.Cache
+}
+
+func (n *peerNetwork) Head(channel string) (*bcgo.Reference, error) {
+	return n.cache.Head(channel)
+}
+
+func (n *peerNetwork) Block(reference *bcgo.Reference) (*bcgo.Block, error) {
+	return n.cache.Block(reference.BlockHash)
+}
+
+func (n *peerNetwork) Broadcast(channel bcgo.Channel, cache bcgo.Cache, hash []byte, block *bcgo.Block) error {
+	if head, err := n.cache.Head(channel.Name()); err == nil {
+		remote, err := n.cache.Block(head.BlockHash)
+		if err != nil {
+			return err
+		}
+		if remote.Length >= block.Length {
+			return bcgo.ErrChainTooShort{Will: block.Length, Was: remote.Length}
+		}
+	}
+	// Send every block the peer is missing
+	if err := bcgo.Iterate(channel.Name(), hash, block, cache, nil, func(h []byte, b *bcgo.Block) error {
+		if _, err := n.cache.Block(h); err == nil {
+			return bcgo.StopIterationError{}
+		}
+		return n.cache.PutBlock(h, b)
+	}); err != nil {
+		if _, ok := err.(bcgo.StopIterationError); !ok {
+			return err
+		}
+	}
+	return n.cache.PutHead(channel.Name(), &bcgo.Reference{
+		Timestamp:   block.Timestamp,
+		ChannelName: channel.Name(),
+		BlockHash:   hash,
+	})
+}
+
+func putHead(t *testing.T, c bcgo.Cache, hash []byte) {
+	t.Helper()
+	if err := c.PutHead("Test", &bcgo.Reference{
+		ChannelName: "Test",
+		BlockHash:   hash,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_SyncPeer(t *testing.T) {
+	local := cache.NewMemory(10)
+	remote := cache.NewMemory(10)
+	network := &peerNetwork{
+		cache: remote,
+	}
+	trunk := makeChain(t, local, "Alice", nil, 0, 2)
+	putHead(t, local, trunk[1])
+	// Peer shares the trunk, then extends it
+	for _, h := range trunk {
+		b, err := local.Block(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := remote.PutBlock(h, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ahead := makeChain(t, remote, "Bob", trunk[1], 2, 3)
+	putHead(t, remote, ahead[2])
+
+	t.Run("Pull", func(t *testing.T) {
+		result := ui.SyncPeer(local, network, "Test", "peer", false, nil)
+		if result.Error != nil {
+			t.Fatal(result.Error)
+		}
+		if !bytes.Equal(result.OldHead, trunk[1]) || !bytes.Equal(result.NewHead, ahead[2]) || !bytes.Equal(result.RemoteHead, ahead[2]) {
+			t.Fatalf("Incorrect heads; got %+v", result)
+		}
+		if result.Blocks != 3 || result.Bytes == 0 {
+			t.Fatalf("Incorrect transfer; expected 3 blocks, got %d blocks %d bytes", result.Blocks, result.Bytes)
+		}
+	})
+
+	more := makeChain(t, local, "Alice", ahead[2], 5, 2)
+	putHead(t, local, more[1])
+
+	t.Run("PullBehind", func(t *testing.T) {
+		result := ui.SyncPeer(local, network, "Test", "peer", false, nil)
+		if result.Error != nil {
+			t.Fatalf("Expected a shorter remote chain not to be an error, got %s", result.Error)
+		}
+		if !result.Behind {
+			t.Fatal("Expected remote to be behind")
+		}
+		if !bytes.Equal(result.NewHead, more[1]) {
+			t.Fatal("Expected head to be unchanged")
+		}
+	})
+
+	t.Run("Push", func(t *testing.T) {
+		result := ui.SyncPeer(local, network, "Test", "peer", true, nil)
+		if result.Error != nil {
+			t.Fatal(result.Error)
+		}
+		if result.Blocks != 2 || result.Bytes == 0 {
+			t.Fatalf("Incorrect transfer; expected 2 blocks, got %d blocks %d bytes", result.Blocks, result.Bytes)
+		}
+		head, err := remote.Head("Test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(head.BlockHash, more[1]) {
+			t.Fatal("Expected remote head to be updated")
+		}
+	})
+}
+
+func Test_IsChainTooShort(t *testing.T) {
+	if !ui.IsChainTooShort(bcgo.ErrChainTooShort{Will: 1, Was: 2}) {
+		t.Fatal("Expected ErrChainTooShort")
+	}
+	if ui.IsChainTooShort(errors.New("Other")) || ui.IsChainTooShort(nil) {
+		t.Fatal("Expected other errors not to be ErrChainTooShort")
+	}
+}
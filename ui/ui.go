@@ -18,8 +18,11 @@ package ui
 
 import (
 	"aletheiaware.com/bcclientgo"
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/widget"
+	"time"
 )
 
 var (
@@ -28,10 +31,46 @@ var (
 )
 
 type UI interface {
+	ContactBook(bcclientgo.BCClient) (storage.ContactBook, error)
+	Inbox(bcclientgo.BCClient) (storage.Inbox, error)
+	KeyCodecs() []storage.KeyCodec
+	Node(bcclientgo.BCClient) (bcgo.Node, error)
+	PreviousAccounts(bcclientgo.BCClient) []bcgo.Account
+	ReferenceIndex(bcclientgo.BCClient) (storage.ReferenceIndex, error)
 	ShowError(error)
 	ShowURI(bcclientgo.BCClient, fyne.URI)
 }
 
+// CopyToClipboard copies the given text to the clipboard of the window showing the given object.
+func CopyToClipboard(o fyne.CanvasObject, text string) {
+	if w := WindowForObject(o); w != nil {
+		w.Clipboard().SetContent(text)
+	}
+}
+
+// CopySecretToClipboard copies the given text to the clipboard of the window and clears it after the timeout, unless it has since been replaced.
+func CopySecretToClipboard(w fyne.Window, text string, timeout time.Duration) {
+	clipboard := w.Clipboard()
+	clipboard.SetContent(text)
+	time.AfterFunc(timeout, func() {
+		if clipboard.Content() == text {
+			clipboard.SetContent("")
+		}
+	})
+}
+
+// WindowForObject returns the window showing the given object, or nil.
+func WindowForObject(o fyne.CanvasObject) fyne.Window {
+	driver := fyne.CurrentApp().Driver()
+	c := driver.CanvasForObject(o)
+	for _, w := range driver.AllWindows() {
+		if w.Canvas() == c {
+			return w
+		}
+	}
+	return nil
+}
+
 func ShortcutFocused(s fyne.Shortcut, w fyne.Window) {
 	if focused, ok := w.Canvas().Focused().(fyne.Shortcutable); ok {
 		focused.TypedShortcut(s)
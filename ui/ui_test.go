@@ -44,6 +44,16 @@ func Test_UI(t *testing.T) {
 				return account.NewImportKey().CanvasObject()
 			},
 		},
+		"account/import_key_codes": {
+			builder: func(w fyne.Window) fyne.CanvasObject {
+				return account.NewImportKeyCodes().CanvasObject()
+			},
+		},
+		"account/open_key_file": {
+			builder: func(w fyne.Window) fyne.CanvasObject {
+				return account.NewOpenKeyFile(w).CanvasObject()
+			},
+		},
 		"account/sign_in": {
 			builder: func(w fyne.Window) fyne.CanvasObject {
 				return account.NewSignIn().CanvasObject()
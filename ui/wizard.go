@@ -0,0 +1,119 @@
+/*
+ * Copyright 2021 Aletheia Ware LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ui
+
+import (
+	"aletheiaware.com/bcfynego/storage"
+	"aletheiaware.com/bcgo"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+	"strings"
+)
+
+// Thresholds maps the name of each proof-of-work threshold to the minimum number of ones.
+var Thresholds = map[string]uint64{
+	"Z": bcgo.THRESHOLD_Z,
+	"I": bcgo.THRESHOLD_I,
+	"G": bcgo.THRESHOLD_G,
+	"F": bcgo.THRESHOLD_F,
+	"E": bcgo.THRESHOLD_E,
+	"D": bcgo.THRESHOLD_D,
+	"C": bcgo.THRESHOLD_C,
+	"B": bcgo.THRESHOLD_B,
+	"A": bcgo.THRESHOLD_A,
+}
+
+// ThresholdNames lists the names of the proof-of-work thresholds from easiest to hardest.
+var ThresholdNames = []string{"Z", "I", "G", "F", "E", "D", "C", "B", "A"}
+
+// ChannelWizard holds the form used to configure a new channel.
+type ChannelWizard struct {
+	Name       *widget.Entry
+	Threshold  *widget.Select
+	Periodic   *widget.Check
+	LivePrefix *widget.Check
+	Preview    *widget.Label
+	Error      *widget.Label
+}
+
+func NewChannelWizard() *ChannelWizard {
+	w := &ChannelWizard{
+		Name: widget.NewEntry(),
+		Preview: &widget.Label{
+			TextStyle: fyne.TextStyle{
+				Monospace: true,
+			},
+			Wrapping: fyne.TextWrapBreak,
+		},
+		Error: &widget.Label{
+			Wrapping: fyne.TextWrapWord,
+		},
+	}
+	w.Name.PlaceHolder = "Name"
+	w.Name.Wrapping = fyne.TextWrapOff
+	w.Name.OnChanged = func(string) {
+		w.update()
+	}
+	w.Threshold = widget.NewSelect(ThresholdNames, nil)
+	w.Threshold.SetSelected("G")
+	w.Periodic = widget.NewCheck("Periodic Validation", nil)
+	w.LivePrefix = widget.NewCheck("Prefix When Not Live", func(bool) {
+		w.update()
+	})
+	return w
+}
+
+func (w *ChannelWizard) CanvasObject() fyne.CanvasObject {
+	return widget.NewForm(
+		widget.NewFormItem("Name", w.Name),
+		widget.NewFormItem("", w.Error),
+		widget.NewFormItem("Threshold", w.Threshold),
+		widget.NewFormItem("Validation", w.Periodic),
+		widget.NewFormItem("Live Flag", w.LivePrefix),
+		widget.NewFormItem("Channel", w.Preview),
+	)
+}
+
+// Options returns the channel options chosen in the form.
+func (w *ChannelWizard) Options() *storage.ChannelOptions {
+	options := storage.DefaultChannelOptions()
+	if t, ok := Thresholds[w.Threshold.Selected]; ok {
+		options.Threshold = t
+	}
+	options.Periodic = w.Periodic.Checked
+	options.LivePrefix = w.LivePrefix.Checked
+	return options
+}
+
+// ChannelName returns the name of the channel as it will be created.
+func (w *ChannelWizard) ChannelName() string {
+	return w.Options().ChannelName(strings.TrimSpace(w.Name.Text))
+}
+
+// Validate returns an error if the channel name is invalid.
+func (w *ChannelWizard) Validate() error {
+	return bcgo.ValidateName(w.ChannelName())
+}
+
+func (w *ChannelWizard) update() {
+	w.Preview.SetText(w.ChannelName())
+	if err := w.Validate(); err != nil && w.Name.Text != "" {
+		w.Error.SetText(err.Error())
+	} else {
+		w.Error.SetText("")
+	}
+}